@@ -89,6 +89,26 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              az.Environment
 	skipProviderRegistration bool
+	hashSensitiveValues      bool
+
+	// features contains the behavioural toggles configured in the Provider's `features` block
+	features features
+
+	// eventualConsistencyTimeout is how long to wait for changes which are replicated after creation to become visible
+	eventualConsistencyTimeout time.Duration
+
+	// listCache caches the results of List operations which aren't affected by Terraform
+	listCache *listCache
+
+	// correlationRequestId is sent with every request, so that the operations performed during a Terraform run can be
+	// found in the Activity Log
+	correlationRequestId string
+
+	// sender is shared between all of the API Clients, so that connections (and their TLS sessions) can be reused
+	sender autorest.Sender
+
+	// getAuthorizationToken returns an Authorizer for the specified Resource (audience) using the Provider's credentials
+	getAuthorizationToken func(resource string) (*autorest.BearerAuthorizer, error)
 
 	StopContext context.Context
 
@@ -141,8 +161,10 @@ type ArmClient struct {
 	apiManagementUsersClient         apimanagement.UserClient
 
 	// Application Insights
-	appInsightsClient       appinsights.ComponentsClient
-	appInsightsAPIKeyClient appinsights.APIKeysClient
+	appInsightsClient                   appinsights.ComponentsClient
+	appInsightsAPIKeyClient             appinsights.APIKeysClient
+	appInsightsSmartDetectionRuleClient appinsights.ProactiveDetectionConfigurationsClient
+	appInsightsWorkbooksClient          appinsights.WorkbooksClient
 
 	// Authentication
 	roleAssignmentsClient   authorization.RoleAssignmentsClient
@@ -169,6 +191,7 @@ type ArmClient struct {
 	availSetClient             compute.AvailabilitySetsClient
 	diskClient                 compute.DisksClient
 	imageClient                compute.ImagesClient
+	resourceSkusClient         compute.ResourceSkusClient
 	galleriesClient            compute.GalleriesClient
 	galleryImagesClient        compute.GalleryImagesClient
 	galleryImageVersionsClient compute.GalleryImageVersionsClient
@@ -193,27 +216,33 @@ type ArmClient struct {
 	devSpaceControllerClient devspaces.ControllersClient
 
 	// Databases
-	mariadbDatabasesClient                   mariadb.DatabasesClient
-	mariadbServersClient                     mariadb.ServersClient
-	mysqlConfigurationsClient                mysql.ConfigurationsClient
-	mysqlDatabasesClient                     mysql.DatabasesClient
-	mysqlFirewallRulesClient                 mysql.FirewallRulesClient
-	mysqlServersClient                       mysql.ServersClient
-	mysqlVirtualNetworkRulesClient           mysql.VirtualNetworkRulesClient
-	postgresqlConfigurationsClient           postgresql.ConfigurationsClient
-	postgresqlDatabasesClient                postgresql.DatabasesClient
-	postgresqlFirewallRulesClient            postgresql.FirewallRulesClient
-	postgresqlServersClient                  postgresql.ServersClient
-	postgresqlVirtualNetworkRulesClient      postgresql.VirtualNetworkRulesClient
-	sqlDatabasesClient                       sql.DatabasesClient
-	sqlDatabaseThreatDetectionPoliciesClient sql.DatabaseThreatDetectionPoliciesClient
-	sqlElasticPoolsClient                    sql.ElasticPoolsClient
+	mariadbDatabasesClient                      mariadb.DatabasesClient
+	mariadbServersClient                        mariadb.ServersClient
+	mariadbServerSecurityAlertPoliciesClient    mariadb.ServerSecurityAlertPoliciesClient
+	mysqlConfigurationsClient                   mysql.ConfigurationsClient
+	mysqlDatabasesClient                        mysql.DatabasesClient
+	mysqlFirewallRulesClient                    mysql.FirewallRulesClient
+	mysqlServersClient                          mysql.ServersClient
+	mysqlServerSecurityAlertPoliciesClient      mysql.ServerSecurityAlertPoliciesClient
+	mysqlVirtualNetworkRulesClient              mysql.VirtualNetworkRulesClient
+	postgresqlConfigurationsClient              postgresql.ConfigurationsClient
+	postgresqlDatabasesClient                   postgresql.DatabasesClient
+	postgresqlFirewallRulesClient               postgresql.FirewallRulesClient
+	postgresqlServersClient                     postgresql.ServersClient
+	postgresqlServerSecurityAlertPoliciesClient postgresql.ServerSecurityAlertPoliciesClient
+	postgresqlVirtualNetworkRulesClient         postgresql.VirtualNetworkRulesClient
+	sqlDatabasesClient                          sql.DatabasesClient
+	sqlDatabaseThreatDetectionPoliciesClient    sql.DatabaseThreatDetectionPoliciesClient
+	sqlElasticPoolsClient                       sql.ElasticPoolsClient
+	sqlEncryptionProtectorsClient               sql.EncryptionProtectorsClient
 	// Client for the new 2017-10-01-preview SQL API which implements vCore, DTU, and Azure data standards
-	msSqlElasticPoolsClient              MsSql.ElasticPoolsClient
-	sqlFirewallRulesClient               sql.FirewallRulesClient
-	sqlServersClient                     sql.ServersClient
-	sqlServerAzureADAdministratorsClient sql.ServerAzureADAdministratorsClient
-	sqlVirtualNetworkRulesClient         sql.VirtualNetworkRulesClient
+	msSqlBackupShortTermRetentionPoliciesClient MsSql.BackupShortTermRetentionPoliciesClient
+	msSqlElasticPoolsClient                     MsSql.ElasticPoolsClient
+	sqlFirewallRulesClient                      sql.FirewallRulesClient
+	sqlServersClient                            sql.ServersClient
+	sqlServerAzureADAdministratorsClient        sql.ServerAzureADAdministratorsClient
+	sqlServerKeysClient                         sql.ServerKeysClient
+	sqlVirtualNetworkRulesClient                sql.VirtualNetworkRulesClient
 
 	// Data Lake Store
 	dataLakeStoreAccountClient       storeAccount.AccountsClient
@@ -236,14 +265,19 @@ type ArmClient struct {
 	workspacesClient     operationalinsights.WorkspacesClient
 
 	// Logic
-	logicWorkflowsClient logic.WorkflowsClient
+	logicIntegrationAccountsClient        logic.IntegrationAccountsClient
+	logicIntegrationAccountMapsClient     logic.MapsClient
+	logicIntegrationAccountPartnersClient logic.PartnersClient
+	logicIntegrationAccountSchemasClient  logic.SchemasClient
+	logicWorkflowsClient                  logic.WorkflowsClient
 
 	// Management Groups
 	managementGroupsClient             managementgroups.Client
 	managementGroupsSubscriptionClient managementgroups.SubscriptionsClient
 
 	// Media
-	mediaServicesClient media.MediaservicesClient
+	mediaServicesClient   media.MediaservicesClient
+	mediaTransformsClient media.TransformsClient
 
 	// Monitor
 	monitorActionGroupsClient               insights.ActionGroupsClient
@@ -276,6 +310,7 @@ type ArmClient struct {
 	secGroupClient                  network.SecurityGroupsClient
 	secRuleClient                   network.SecurityRulesClient
 	subnetClient                    network.SubnetsClient
+	networkUsagesClient             network.UsagesClient
 	vnetGatewayConnectionsClient    network.VirtualNetworkGatewayConnectionsClient
 	vnetGatewayClient               network.VirtualNetworkGatewaysClient
 	vnetClient                      network.VirtualNetworksClient
@@ -287,12 +322,17 @@ type ArmClient struct {
 	notificationNamespacesClient notificationhubs.NamespacesClient
 
 	// Recovery Services
-	recoveryServicesVaultsClient             recoveryservices.VaultsClient
-	recoveryServicesProtectedItemsClient     backup.ProtectedItemsGroupClient
-	recoveryServicesProtectionPoliciesClient backup.ProtectionPoliciesClient
+	recoveryServicesVaultsClient               recoveryservices.VaultsClient
+	recoveryServicesProtectedItemsClient       backup.ProtectedItemsGroupClient
+	recoveryServicesProtectionPoliciesClient   backup.ProtectionPoliciesClient
+	recoveryServicesProtectionContainersClient backup.ProtectionContainersClient
+	recoveryServicesStorageConfigsClient       backup.ResourceStorageConfigsClient
+	recoveryServicesProtectableItemsClient     backup.ProtectableItemsClient
+	recoveryServicesBackupProtectedItemsClient backup.ProtectedItemsClient
 
 	// Relay
-	relayNamespacesClient relay.NamespacesClient
+	relayHybridConnectionsClient relay.HybridConnectionsClient
+	relayNamespacesClient        relay.NamespacesClient
 
 	// Resources
 	managementLocksClient locks.ManagementLocksClient
@@ -372,7 +412,10 @@ func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Autho
 	setUserAgent(client, c.partnerId)
 	client.Authorizer = auth
 	//client.RequestInspector = azure.WithClientID(clientRequestID())
-	client.Sender = azure.BuildSender()
+	client.RequestInspector = azure.WithCorrelationRequestID(func() string {
+		return c.correlationRequestId
+	})
+	client.Sender = c.sender
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
 	client.PollingDuration = 60 * time.Minute
 }
@@ -399,7 +442,7 @@ func setUserAgent(client *autorest.Client, partnerID string) {
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string) (*ArmClient, error) {
+func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string, maxIdleConnsPerHost int) (*ArmClient, error) {
 	env, err := authentication.DetermineEnvironment(c.Environment)
 	if err != nil {
 		return nil, err
@@ -414,7 +457,16 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		environment:              *env,
 		usingServicePrincipal:    c.AuthenticatedAsAServicePrincipal,
 		skipProviderRegistration: skipProviderRegistration,
+		listCache:                newListCache(),
+		sender:                   azure.BuildSenderWithMaxIdleConnsPerHost(maxIdleConnsPerHost),
+		features:                 defaultFeatures(),
+	}
+
+	correlationRequestId, err := uuid.GenerateUUID()
+	if err != nil {
+		log.Printf("[WARN] Unable to generate a Correlation Request ID: %+v", err)
 	}
+	client.correlationRequestId = correlationRequestId
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
 	if err != nil {
@@ -440,10 +492,13 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return nil, err
 	}
 
+	client.getAuthorizationToken = func(resource string) (*autorest.BearerAuthorizer, error) {
+		return c.GetAuthorizationToken(oauthConfig, resource)
+	}
+
 	// Key Vault Endpoints
-	sender := azure.BuildSender()
-	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
-		keyVaultSpt, err := c.GetAuthorizationToken(oauthConfig, resource)
+	keyVaultAuth := autorest.NewBearerAuthorizerCallback(client.sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+		keyVaultSpt, err := client.getAuthorizationToken(resource)
 		if err != nil {
 			return nil, err
 		}
@@ -464,7 +519,7 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 	client.registerContainerServicesClients(endpoint, c.SubscriptionID, auth)
 	client.registerCosmosDBClients(endpoint, c.SubscriptionID, auth)
 	client.registerDatabricksClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
+	client.registerDatabases(endpoint, c.SubscriptionID, auth, client.sender)
 	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth)
 	client.registerDeviceClients(endpoint, c.SubscriptionID, auth)
 	client.registerDevSpaceClients(endpoint, c.SubscriptionID, auth)
@@ -556,6 +611,14 @@ func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string,
 	aiak := appinsights.NewAPIKeysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&aiak.Client, auth)
 	c.appInsightsAPIKeyClient = aiak
+
+	aisdr := appinsights.NewProactiveDetectionConfigurationsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&aisdr.Client, auth)
+	c.appInsightsSmartDetectionRuleClient = aisdr
+
+	aiwb := appinsights.NewWorkbooksClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&aiwb.Client, auth)
+	c.appInsightsWorkbooksClient = aiwb
 }
 
 func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -654,6 +717,10 @@ func (c *ArmClient) registerMediaServiceClients(endpoint, subscriptionId string,
 	mediaServicesClient := media.NewMediaservicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mediaServicesClient.Client, auth)
 	c.mediaServicesClient = mediaServicesClient
+
+	mediaTransformsClient := media.NewTransformsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&mediaTransformsClient.Client, auth)
+	c.mediaTransformsClient = mediaTransformsClient
 }
 
 func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -669,6 +736,10 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&imagesClient.Client, auth)
 	c.imageClient = imagesClient
 
+	resourceSkusClient := compute.NewResourceSkusClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&resourceSkusClient.Client, auth)
+	c.resourceSkusClient = resourceSkusClient
+
 	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&snapshotsClient.Client, auth)
 	c.snapshotsClient = snapshotsClient
@@ -754,6 +825,10 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&mariadbServersClient.Client, auth)
 	c.mariadbServersClient = mariadbServersClient
 
+	mariadbServerSecurityAlertPoliciesClient := mariadb.NewServerSecurityAlertPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&mariadbServerSecurityAlertPoliciesClient.Client, auth)
+	c.mariadbServerSecurityAlertPoliciesClient = mariadbServerSecurityAlertPoliciesClient
+
 	// MySQL
 	mysqlConfigClient := mysql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlConfigClient.Client, auth)
@@ -771,6 +846,10 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&mysqlServersClient.Client, auth)
 	c.mysqlServersClient = mysqlServersClient
 
+	mysqlServerSecurityAlertPoliciesClient := mysql.NewServerSecurityAlertPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&mysqlServerSecurityAlertPoliciesClient.Client, auth)
+	c.mysqlServerSecurityAlertPoliciesClient = mysqlServerSecurityAlertPoliciesClient
+
 	mysqlVirtualNetworkRulesClient := mysql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlVirtualNetworkRulesClient.Client, auth)
 	c.mysqlVirtualNetworkRulesClient = mysqlVirtualNetworkRulesClient
@@ -792,6 +871,10 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&postgresqlSrvClient.Client, auth)
 	c.postgresqlServersClient = postgresqlSrvClient
 
+	postgresqlServerSecurityAlertPoliciesClient := postgresql.NewServerSecurityAlertPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&postgresqlServerSecurityAlertPoliciesClient.Client, auth)
+	c.postgresqlServerSecurityAlertPoliciesClient = postgresqlServerSecurityAlertPoliciesClient
+
 	postgresqlVNRClient := postgresql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlVNRClient.Client, auth)
 	c.postgresqlVirtualNetworkRulesClient = postgresqlVNRClient
@@ -816,6 +899,14 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&sqlEPClient.Client, auth)
 	c.sqlElasticPoolsClient = sqlEPClient
 
+	sqlEncryptionProtectorsClient := sql.NewEncryptionProtectorsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlEncryptionProtectorsClient.Client, auth)
+	c.sqlEncryptionProtectorsClient = sqlEncryptionProtectorsClient
+
+	MsSqlBSTRPClient := MsSql.NewBackupShortTermRetentionPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&MsSqlBSTRPClient.Client, auth)
+	c.msSqlBackupShortTermRetentionPoliciesClient = MsSqlBSTRPClient
+
 	MsSqlEPClient := MsSql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&MsSqlEPClient.Client, auth)
 	c.msSqlElasticPoolsClient = MsSqlEPClient
@@ -828,6 +919,10 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&sqlADClient.Client, auth)
 	c.sqlServerAzureADAdministratorsClient = sqlADClient
 
+	sqlServerKeysClient := sql.NewServerKeysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlServerKeysClient.Client, auth)
+	c.sqlServerKeysClient = sqlServerKeysClient
+
 	sqlVNRClient := sql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlVNRClient.Client, auth)
 	c.sqlVirtualNetworkRulesClient = sqlVNRClient
@@ -934,6 +1029,22 @@ func (c *ArmClient) registerKeyVaultClients(endpoint, subscriptionId string, aut
 }
 
 func (c *ArmClient) registerLogicClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	integrationAccountsClient := logic.NewIntegrationAccountsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&integrationAccountsClient.Client, auth)
+	c.logicIntegrationAccountsClient = integrationAccountsClient
+
+	mapsClient := logic.NewMapsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&mapsClient.Client, auth)
+	c.logicIntegrationAccountMapsClient = mapsClient
+
+	partnersClient := logic.NewPartnersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&partnersClient.Client, auth)
+	c.logicIntegrationAccountPartnersClient = partnersClient
+
+	schemasClient := logic.NewSchemasClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&schemasClient.Client, auth)
+	c.logicIntegrationAccountSchemasClient = schemasClient
+
 	workflowsClient := logic.NewWorkflowsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&workflowsClient.Client, auth)
 	c.logicWorkflowsClient = workflowsClient
@@ -1062,6 +1173,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&subnetsClient.Client, auth)
 	c.subnetClient = subnetsClient
 
+	networkUsagesClient := network.NewUsagesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&networkUsagesClient.Client, auth)
+	c.networkUsagesClient = networkUsagesClient
+
 	userAssignedIdentitiesClient := msi.NewUserAssignedIdentitiesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&userAssignedIdentitiesClient.Client, auth)
 	c.userAssignedIdentitiesClient = userAssignedIdentitiesClient
@@ -1107,6 +1222,22 @@ func (c *ArmClient) registerRecoveryServiceClients(endpoint, subscriptionId stri
 	protectionPoliciesClient := backup.NewProtectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&protectionPoliciesClient.Client, auth)
 	c.recoveryServicesProtectionPoliciesClient = protectionPoliciesClient
+
+	protectionContainersClient := backup.NewProtectionContainersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&protectionContainersClient.Client, auth)
+	c.recoveryServicesProtectionContainersClient = protectionContainersClient
+
+	storageConfigsClient := backup.NewResourceStorageConfigsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&storageConfigsClient.Client, auth)
+	c.recoveryServicesStorageConfigsClient = storageConfigsClient
+
+	protectableItemsClient := backup.NewProtectableItemsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&protectableItemsClient.Client, auth)
+	c.recoveryServicesProtectableItemsClient = protectableItemsClient
+
+	backupProtectedItemsClient := backup.NewProtectedItemsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&backupProtectedItemsClient.Client, auth)
+	c.recoveryServicesBackupProtectedItemsClient = backupProtectedItemsClient
 }
 
 func (c *ArmClient) registerRedisClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1124,6 +1255,10 @@ func (c *ArmClient) registerRedisClients(endpoint, subscriptionId string, auth a
 }
 
 func (c *ArmClient) registerRelayClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	relayHybridConnectionsClient := relay.NewHybridConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&relayHybridConnectionsClient.Client, auth)
+	c.relayHybridConnectionsClient = relayHybridConnectionsClient
+
 	relayNamespacesClient := relay.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&relayNamespacesClient.Client, auth)
 	c.relayNamespacesClient = relayNamespacesClient
@@ -0,0 +1,108 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmAccessToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmAccessTokenRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"access_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expires_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmAccessTokenRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	ctx := meta.(*ArmClient).StopContext
+
+	resource := d.Get("resource").(string)
+
+	auth, err := client.getAuthorizationToken(resource)
+	if err != nil {
+		return fmt.Errorf("Error obtaining an Access Token for Resource %q: %+v", resource, err)
+	}
+
+	// the BearerAuthorizer doesn't expose the token, however it refreshes (or acquires) the token when preparing a request
+	req, err := http.NewRequest(http.MethodGet, client.environment.ResourceManagerEndpoint, nil)
+	if err != nil {
+		return fmt.Errorf("Error building request: %+v", err)
+	}
+
+	req, err = autorest.Prepare(req.WithContext(ctx), auth.WithAuthorization())
+	if err != nil {
+		return fmt.Errorf("Error obtaining an Access Token for Resource %q: %+v", resource, err)
+	}
+
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		return fmt.Errorf("Error obtaining an Access Token for Resource %q: no token was returned", resource)
+	}
+
+	d.SetId(fmt.Sprintf("accessToken-%s", resource))
+	d.Set("tenant_id", client.tenantId)
+	d.Set("access_token", token)
+
+	expiresOn := ""
+	if v := accessTokenExpiry(token); v != nil {
+		expiresOn = v.Format(time.RFC3339)
+	}
+	d.Set("expires_on", expiresOn)
+
+	return nil
+}
+
+// accessTokenExpiry returns the expiry time (the `exp` claim) of a JWT Access Token,
+// or nil if this can't be determined
+func accessTokenExpiry(token string) *time.Time {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return nil
+	}
+
+	var claims struct {
+		ExpiresOn *int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.ExpiresOn == nil {
+		return nil
+	}
+
+	expiry := time.Unix(*claims.ExpiresOn, 0).UTC()
+	return &expiry
+}
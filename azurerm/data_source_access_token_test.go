@@ -0,0 +1,80 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccessTokenExpiry(t *testing.T) {
+	encode := func(input string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(input))
+	}
+
+	cases := []struct {
+		Token    string
+		Expected *time.Time
+	}{
+		{
+			Token:    "",
+			Expected: nil,
+		},
+		{
+			Token:    "not-a-jwt",
+			Expected: nil,
+		},
+		{
+			Token:    fmt.Sprintf("%s.%s.signature", encode(`{"typ":"JWT"}`), encode(`{"aud":"https://management.azure.com/"}`)),
+			Expected: nil,
+		},
+		{
+			Token:    fmt.Sprintf("%s.%s.signature", encode(`{"typ":"JWT"}`), encode(`{"aud":"https://management.azure.com/","exp":1546300800}`)),
+			Expected: func() *time.Time { v := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC); return &v }(),
+		},
+	}
+
+	for _, v := range cases {
+		actual := accessTokenExpiry(v.Token)
+		if v.Expected == nil {
+			if actual != nil {
+				t.Fatalf("Expected no expiry for %q but got %s", v.Token, actual)
+			}
+			continue
+		}
+
+		if actual == nil || !actual.Equal(*v.Expected) {
+			t.Fatalf("Expected the expiry for %q to be %s but got %v", v.Token, v.Expected, actual)
+		}
+	}
+}
+
+func TestAccDataSourceAzureRMAccessToken_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_access_token.test"
+	tenantId := os.Getenv("ARM_TENANT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMAccessToken_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tenant_id", tenantId),
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_token"),
+					resource.TestMatchResourceAttr(dataSourceName, "expires_on", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAzureRMAccessToken_basic = `
+data "azurerm_access_token" "test" {
+  resource = "https://management.azure.com/"
+}
+`
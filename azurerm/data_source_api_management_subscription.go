@@ -0,0 +1,91 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmApiManagementSubscription() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmApiManagementSubscriptionRead,
+
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"api_management_name": azure.SchemaApiManagementDataSourceName(),
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"product_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmApiManagementSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementSubscriptionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	subscriptionId := d.Get("subscription_id").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, subscriptionId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Subscription %q was not found in API Management Service %q / Resource Group %q", subscriptionId, serviceName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	if props := resp.SubscriptionContractProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("primary_key", props.PrimaryKey)
+		d.Set("secondary_key", props.SecondaryKey)
+		d.Set("state", string(props.State))
+		d.Set("product_id", props.ProductID)
+		d.Set("user_id", props.UserID)
+	}
+
+	return nil
+}
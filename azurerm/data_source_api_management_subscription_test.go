@@ -0,0 +1,46 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMApiManagementSubscription_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_api_management_subscription.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApiManagementSubscription_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", "Butter Parser API Enterprise Edition"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "active"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "product_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "user_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secondary_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApiManagementSubscription_basic(rInt int, location string) string {
+	template := testAccAzureRMAPIManagementSubscription_complete(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_api_management_subscription" "test" {
+  subscription_id     = "${azurerm_api_management_subscription.test.subscription_id}"
+  api_management_name = "${azurerm_api_management_subscription.test.api_management_name}"
+  resource_group_name = "${azurerm_api_management_subscription.test.resource_group_name}"
+}
+`, template)
+}
@@ -149,6 +149,10 @@ func dataSourceArmCosmosDBAccount() *schema.Resource {
 				},
 			},
 
+			"read_locations": cosmosDBAccountRegionalEndpointsSchema(),
+
+			"write_locations": cosmosDBAccountRegionalEndpointsSchema(),
+
 			"primary_master_key": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -250,6 +254,14 @@ func dataSourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{})
 		}
 		d.Set("write_endpoints", writeEndpoints)
 
+		if err = d.Set("read_locations", flattenAzureRmCosmosDBAccountRegionalEndpoints(props.ReadLocations)); err != nil {
+			return fmt.Errorf("Error setting `read_locations`: %+v", err)
+		}
+
+		if err = d.Set("write_locations", flattenAzureRmCosmosDBAccountRegionalEndpoints(props.WriteLocations)); err != nil {
+			return fmt.Errorf("Error setting `write_locations`: %+v", err)
+		}
+
 		d.Set("enable_multiple_write_locations", resp.EnableMultipleWriteLocations)
 	}
 
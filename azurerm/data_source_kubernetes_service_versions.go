@@ -0,0 +1,132 @@
+package azurerm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmKubernetesServiceVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmKubernetesServiceVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": locationSchema(),
+
+			"version_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"include_preview": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmKubernetesServiceVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containerServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	versionPrefix := d.Get("version_prefix").(string)
+	includePreview := d.Get("include_preview").(bool)
+
+	listResp, err := client.ListOrchestrators(ctx, location, "managedClusters")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Kubernetes Versions in %q: %+v", location, err)
+	}
+
+	if listResp.ID == nil || *listResp.ID == "" {
+		return fmt.Errorf("Error retrieving Kubernetes Versions in %q: ID was nil or empty", location)
+	}
+
+	available := make([]string, 0)
+	if props := listResp.OrchestratorVersionProfileProperties; props != nil && props.Orchestrators != nil {
+		for _, profile := range *props.Orchestrators {
+			if profile.OrchestratorType == nil || profile.OrchestratorVersion == nil {
+				continue
+			}
+
+			if !strings.EqualFold(*profile.OrchestratorType, "Kubernetes") {
+				continue
+			}
+
+			available = append(available, *profile.OrchestratorVersion)
+		}
+	}
+
+	versions, err := filterKubernetesServiceVersions(available, versionPrefix, includePreview)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("Error: no Kubernetes Versions matching the prefix %q were found in %q", versionPrefix, location)
+	}
+
+	d.SetId(*listResp.ID)
+	d.Set("location", location)
+
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("Error setting `versions`: %+v", err)
+	}
+	d.Set("latest_version", versions[len(versions)-1])
+
+	return nil
+}
+
+// filterKubernetesServiceVersions returns the versions matching the specified prefix in ascending order. Since the
+// API doesn't expose whether a version is in preview, versions with a pre-release segment (e.g. `1.13.0-beta.1`)
+// are treated as previews.
+func filterKubernetesServiceVersions(input []string, prefix string, includePreview bool) ([]string, error) {
+	parsed := make(map[*version.Version]string)
+	versions := make([]*version.Version, 0)
+	for _, raw := range input {
+		if !strings.HasPrefix(raw, prefix) {
+			continue
+		}
+
+		v, err := version.NewVersion(raw)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing Kubernetes Version %q: %+v", raw, err)
+		}
+
+		if !includePreview && v.Prerelease() != "" {
+			continue
+		}
+
+		parsed[v] = raw
+		versions = append(versions, v)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].LessThan(versions[j])
+	})
+
+	output := make([]string, 0)
+	for _, v := range versions {
+		output = append(output, parsed[v])
+	}
+
+	return output, nil
+}
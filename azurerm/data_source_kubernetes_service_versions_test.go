@@ -0,0 +1,115 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestFilterKubernetesServiceVersions(t *testing.T) {
+	input := []string{"1.11.5", "1.9.11", "1.13.0-beta.1", "1.11.10", "1.12.4", "1.10.12"}
+
+	cases := []struct {
+		Name           string
+		Prefix         string
+		IncludePreview bool
+		Expected       []string
+	}{
+		{
+			Name:           "All",
+			Prefix:         "",
+			IncludePreview: true,
+			Expected:       []string{"1.9.11", "1.10.12", "1.11.5", "1.11.10", "1.12.4", "1.13.0-beta.1"},
+		},
+		{
+			Name:           "Excluding Previews",
+			Prefix:         "",
+			IncludePreview: false,
+			Expected:       []string{"1.9.11", "1.10.12", "1.11.5", "1.11.10", "1.12.4"},
+		},
+		{
+			Name:           "Minor Version",
+			Prefix:         "1.11.",
+			IncludePreview: true,
+			Expected:       []string{"1.11.5", "1.11.10"},
+		},
+		{
+			Name:           "No Matches",
+			Prefix:         "2.",
+			IncludePreview: true,
+			Expected:       []string{},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := filterKubernetesServiceVersions(input, v.Prefix, v.IncludePreview)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestAccDataSourceAzureRMKubernetesServiceVersions_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_kubernetes_service_versions.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMKubernetesServiceVersions_basic(location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "versions.#", regexp.MustCompile("^[1-9][0-9]*$")),
+					resource.TestCheckResourceAttrSet(dataSourceName, "latest_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMKubernetesServiceVersions_filtered(t *testing.T) {
+	dataSourceName := "data.azurerm_kubernetes_service_versions.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMKubernetesServiceVersions_filtered(location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "versions.#", regexp.MustCompile("^[1-9][0-9]*$")),
+					resource.TestMatchResourceAttr(dataSourceName, "latest_version", regexp.MustCompile(`^1\.`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMKubernetesServiceVersions_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_kubernetes_service_versions" "test" {
+  location = "%s"
+}
+`, location)
+}
+
+func testAccDataSourceAzureRMKubernetesServiceVersions_filtered(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_kubernetes_service_versions" "test" {
+  location        = "%s"
+  version_prefix  = "1."
+  include_preview = false
+}
+`, location)
+}
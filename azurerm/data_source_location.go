@@ -0,0 +1,238 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmLocation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLocationRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"latitude": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"longitude": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceArmLocationRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.subscriptionsClient
+	ctx := armClient.StopContext
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	resp, err := client.ListLocations(ctx, armClient.subscriptionId)
+	if err != nil {
+		return fmt.Errorf("Error listing Locations for Subscription %q: %+v", armClient.subscriptionId, err)
+	}
+
+	// the location can be specified using either the name (e.g. `westeurope`) or the display name (e.g. `West Europe`)
+	var found *string
+	if resp.Value != nil {
+		for _, v := range *resp.Value {
+			if v.Name == nil {
+				continue
+			}
+
+			displayName := ""
+			if v.DisplayName != nil {
+				displayName = *v.DisplayName
+			}
+
+			if azureRMNormalizeLocation(*v.Name) != location && azureRMNormalizeLocation(displayName) != location {
+				continue
+			}
+
+			d.SetId(*v.ID)
+			d.Set("name", v.Name)
+			d.Set("display_name", v.DisplayName)
+			d.Set("latitude", v.Latitude)
+			d.Set("longitude", v.Longitude)
+			found = v.Name
+			break
+		}
+	}
+
+	if found == nil {
+		return fmt.Errorf("Error: Location %q was not found or isn't available for Subscription %q", location, armClient.subscriptionId)
+	}
+
+	skus, err := listComputeResourceSkusInLocation(ctx, armClient, *found, "virtualMachines")
+	if err != nil {
+		return err
+	}
+
+	// the zones available in a location are the union of the zones any Virtual Machine size is available in
+	zones := make(map[string]bool)
+	for _, sku := range skus {
+		for _, zone := range computeResourceSkuZonesInLocation(sku, *found) {
+			zones[zone] = true
+		}
+	}
+
+	if err := d.Set("zones", sortedKeys(zones)); err != nil {
+		return fmt.Errorf("Error setting `zones`: %+v", err)
+	}
+
+	return nil
+}
+
+// listComputeResourceSkusInLocation returns the Compute Resource SKUs of the specified Resource Type (e.g. `virtualMachines`)
+// which are available to the current Subscription within the specified location
+func listComputeResourceSkusInLocation(ctx context.Context, client *ArmClient, location string, resourceType string) ([]compute.ResourceSku, error) {
+	all, err := listComputeResourceSkus(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	skus := make([]compute.ResourceSku, 0)
+	for _, sku := range all {
+		if sku.ResourceType == nil || !strings.EqualFold(*sku.ResourceType, resourceType) || sku.Locations == nil {
+			continue
+		}
+
+		for _, v := range *sku.Locations {
+			if azureRMNormalizeLocation(v) == azureRMNormalizeLocation(location) && !computeResourceSkuIsRestrictedInLocation(sku, location) {
+				skus = append(skus, sku)
+				break
+			}
+		}
+	}
+
+	return skus, nil
+}
+
+// listComputeResourceSkus returns all of the Compute Resource SKUs available to the current Subscription. This is a
+// large (multi-page) response which doesn't change during a plan/apply, so it's cached for each Subscription
+func listComputeResourceSkus(ctx context.Context, client *ArmClient) ([]compute.ResourceSku, error) {
+	result, err := client.listCache.get(func() (interface{}, error) {
+		skus := make([]compute.ResourceSku, 0)
+
+		iter, err := client.resourceSkusClient.ListComplete(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing Compute Resource SKUs: %+v", err)
+		}
+
+		for iter.NotDone() {
+			skus = append(skus, iter.Value())
+
+			if err := iter.NextWithContext(ctx); err != nil {
+				return nil, fmt.Errorf("Error listing Compute Resource SKUs: %+v", err)
+			}
+		}
+
+		return skus, nil
+	}, "computeResourceSkus", client.subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]compute.ResourceSku), nil
+}
+
+func computeResourceSkuIsRestrictedInLocation(sku compute.ResourceSku, location string) bool {
+	if sku.Restrictions == nil {
+		return false
+	}
+
+	for _, restriction := range *sku.Restrictions {
+		if restriction.Type != compute.Location || restriction.Values == nil {
+			continue
+		}
+
+		for _, v := range *restriction.Values {
+			if azureRMNormalizeLocation(v) == azureRMNormalizeLocation(location) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func computeResourceSkuZonesInLocation(sku compute.ResourceSku, location string) []string {
+	zones := make([]string, 0)
+	if sku.LocationInfo == nil {
+		return zones
+	}
+
+	// zones can be restricted for the current Subscription, in which case they're not available for use
+	restricted := make(map[string]bool)
+	if sku.Restrictions != nil {
+		for _, restriction := range *sku.Restrictions {
+			if restriction.Type != compute.Zone || restriction.RestrictionInfo == nil || restriction.RestrictionInfo.Zones == nil {
+				continue
+			}
+
+			for _, zone := range *restriction.RestrictionInfo.Zones {
+				restricted[zone] = true
+			}
+		}
+	}
+
+	for _, info := range *sku.LocationInfo {
+		if info.Location == nil || azureRMNormalizeLocation(*info.Location) != azureRMNormalizeLocation(location) {
+			continue
+		}
+
+		if info.Zones == nil {
+			continue
+		}
+
+		for _, zone := range *info.Zones {
+			if !restricted[zone] {
+				zones = append(zones, zone)
+			}
+		}
+	}
+
+	sort.Strings(zones)
+	return zones
+}
+
+func sortedKeys(input map[string]bool) []string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
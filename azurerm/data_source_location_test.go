@@ -0,0 +1,55 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMLocation_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_location.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMLocation_basic("West Europe"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", "westeurope"),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", "West Europe"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "latitude"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "longitude"),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMLocation_name(t *testing.T) {
+	dataSourceName := "data.azurerm_location.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMLocation_basic("westeurope"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", "westeurope"),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", "West Europe"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMLocation_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_location" "test" {
+  location = "%s"
+}
+`, location)
+}
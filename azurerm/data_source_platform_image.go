@@ -3,6 +3,8 @@ package azurerm
 import (
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -45,13 +47,30 @@ func dataSourceArmPlatformImageRead(d *schema.ResourceData, meta interface{}) er
 	offer := d.Get("offer").(string)
 	sku := d.Get("sku").(string)
 
-	result, err := client.List(ctx, location, publisher, offer, sku, "", utils.Int32(int32(1000)), "name")
+	// the versions of a Platform Image don't change during a plan/apply, so these are cached
+	raw, err := meta.(*ArmClient).listCache.get(func() (interface{}, error) {
+		result, err := client.List(ctx, location, publisher, offer, sku, "", utils.Int32(int32(1000)), "name")
+		if err != nil {
+			return nil, fmt.Errorf("Error reading Platform Images: %+v", err)
+		}
+
+		if result.Value == nil {
+			return []compute.VirtualMachineImageResource{}, nil
+		}
+
+		return *result.Value, nil
+	}, "platformImages", location, publisher, offer, sku)
 	if err != nil {
-		return fmt.Errorf("Error reading Platform Images: %+v", err)
+		return err
+	}
+
+	images := raw.([]compute.VirtualMachineImageResource)
+	if len(images) == 0 {
+		return fmt.Errorf("Error: no Platform Images were found for Publisher %q / Offer %q / SKU %q in %q", publisher, offer, sku, location)
 	}
 
 	// the last value is the latest, apparently.
-	latestVersion := (*result.Value)[len(*result.Value)-1]
+	latestVersion := images[len(images)-1]
 
 	d.SetId(*latestVersion.ID)
 	if location := latestVersion.Location; location != nil {
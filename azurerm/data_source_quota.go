@@ -0,0 +1,272 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+const (
+	quotaResourceProviderCompute = "Microsoft.Compute"
+	quotaResourceProviderNetwork = "Microsoft.Network"
+	quotaResourceProviderStorage = "Microsoft.Storage"
+)
+
+type quotaUsage struct {
+	resourceProvider string
+	name             string
+	displayName      string
+	unit             string
+	currentValue     int64
+	limit            int64
+}
+
+func dataSourceArmQuota() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmQuotaRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": locationSchema(),
+
+			"resource_provider": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					quotaResourceProviderCompute,
+					quotaResourceProviderNetwork,
+					quotaResourceProviderStorage,
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"current_value": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmQuotaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	ctx := client.StopContext
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resourceProvider := d.Get("resource_provider").(string)
+	name := d.Get("name").(string)
+
+	usages := make([]quotaUsage, 0)
+
+	if resourceProvider == "" || strings.EqualFold(resourceProvider, quotaResourceProviderCompute) {
+		v, err := listComputeQuotaUsages(ctx, client, location)
+		if err != nil {
+			return err
+		}
+		usages = append(usages, v...)
+	}
+
+	if resourceProvider == "" || strings.EqualFold(resourceProvider, quotaResourceProviderNetwork) {
+		v, err := listNetworkQuotaUsages(ctx, client, location)
+		if err != nil {
+			return err
+		}
+		usages = append(usages, v...)
+	}
+
+	if resourceProvider == "" || strings.EqualFold(resourceProvider, quotaResourceProviderStorage) {
+		v, err := listStorageQuotaUsages(ctx, client, location)
+		if err != nil {
+			return err
+		}
+		usages = append(usages, v...)
+	}
+
+	quotas := make([]interface{}, 0)
+	for _, usage := range usages {
+		if name != "" && !strings.EqualFold(usage.name, name) {
+			continue
+		}
+
+		quotas = append(quotas, map[string]interface{}{
+			"resource_provider": usage.resourceProvider,
+			"name":              usage.name,
+			"display_name":      usage.displayName,
+			"unit":              usage.unit,
+			"current_value":     int(usage.currentValue),
+			"limit":             int(usage.limit),
+		})
+	}
+
+	if name != "" && len(quotas) == 0 {
+		return fmt.Errorf("Error: Quota %q was not found in Location %q", name, location)
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/locations/%s/quotas", client.subscriptionId, location))
+	if err := d.Set("quotas", quotas); err != nil {
+		return fmt.Errorf("Error setting `quotas`: %+v", err)
+	}
+
+	return nil
+}
+
+func listComputeQuotaUsages(ctx context.Context, client *ArmClient, location string) ([]quotaUsage, error) {
+	usages := make([]quotaUsage, 0)
+
+	iter, err := client.usageOpsClient.ListComplete(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Compute Usages in Location %q: %+v", location, err)
+	}
+
+	for iter.NotDone() {
+		v := iter.Value()
+
+		usage := quotaUsage{
+			resourceProvider: quotaResourceProviderCompute,
+		}
+		if v.Name != nil {
+			if v.Name.Value != nil {
+				usage.name = *v.Name.Value
+			}
+			if v.Name.LocalizedValue != nil {
+				usage.displayName = *v.Name.LocalizedValue
+			}
+		}
+		if v.Unit != nil {
+			usage.unit = *v.Unit
+		}
+		if v.CurrentValue != nil {
+			usage.currentValue = int64(*v.CurrentValue)
+		}
+		if v.Limit != nil {
+			usage.limit = *v.Limit
+		}
+		usages = append(usages, usage)
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Compute Usages in Location %q: %+v", location, err)
+		}
+	}
+
+	return usages, nil
+}
+
+func listNetworkQuotaUsages(ctx context.Context, client *ArmClient, location string) ([]quotaUsage, error) {
+	usages := make([]quotaUsage, 0)
+
+	iter, err := client.networkUsagesClient.ListComplete(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Network Usages in Location %q: %+v", location, err)
+	}
+
+	for iter.NotDone() {
+		v := iter.Value()
+
+		usage := quotaUsage{
+			resourceProvider: quotaResourceProviderNetwork,
+		}
+		if v.Name != nil {
+			if v.Name.Value != nil {
+				usage.name = *v.Name.Value
+			}
+			if v.Name.LocalizedValue != nil {
+				usage.displayName = *v.Name.LocalizedValue
+			}
+		}
+		if v.Unit != nil {
+			usage.unit = *v.Unit
+		}
+		if v.CurrentValue != nil {
+			usage.currentValue = *v.CurrentValue
+		}
+		if v.Limit != nil {
+			usage.limit = *v.Limit
+		}
+		usages = append(usages, usage)
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Network Usages in Location %q: %+v", location, err)
+		}
+	}
+
+	return usages, nil
+}
+
+func listStorageQuotaUsages(ctx context.Context, client *ArmClient, location string) ([]quotaUsage, error) {
+	usages := make([]quotaUsage, 0)
+
+	resp, err := client.storageUsageClient.ListByLocation(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Storage Usages in Location %q: %+v", location, err)
+	}
+
+	if resp.Value == nil {
+		return usages, nil
+	}
+
+	for _, v := range *resp.Value {
+		usage := quotaUsage{
+			resourceProvider: quotaResourceProviderStorage,
+			unit:             string(v.Unit),
+		}
+		if v.Name != nil {
+			if v.Name.Value != nil {
+				usage.name = *v.Name.Value
+			}
+			if v.Name.LocalizedValue != nil {
+				usage.displayName = *v.Name.LocalizedValue
+			}
+		}
+		if v.CurrentValue != nil {
+			usage.currentValue = int64(*v.CurrentValue)
+		}
+		if v.Limit != nil {
+			usage.limit = int64(*v.Limit)
+		}
+		usages = append(usages, usage)
+	}
+
+	return usages, nil
+}
@@ -0,0 +1,65 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMQuota_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_quota.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMQuota_basic(testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "quotas.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "quotas.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "quotas.0.limit"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMQuota_specific(t *testing.T) {
+	dataSourceName := "data.azurerm_quota.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMQuota_specific(testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "quotas.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "quotas.0.resource_provider", "Microsoft.Compute"),
+					resource.TestCheckResourceAttr(dataSourceName, "quotas.0.name", "cores"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "quotas.0.current_value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMQuota_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_quota" "test" {
+  location = "%s"
+}
+`, location)
+}
+
+func testAccDataSourceAzureRMQuota_specific(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_quota" "test" {
+  location          = "%s"
+  resource_provider = "Microsoft.Compute"
+  name              = "cores"
+}
+`, location)
+}
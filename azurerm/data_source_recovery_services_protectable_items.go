@@ -0,0 +1,263 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// recoveryServicesBackupItem contains the properties common to the (polymorphic) Protectable and Protected Items
+type recoveryServicesBackupItem struct {
+	BackupManagementType string `json:"backupManagementType"`
+	WorkloadType         string `json:"workloadType"`
+	FriendlyName         string `json:"friendlyName"`
+	ProtectionState      string `json:"protectionState"`
+	VirtualMachineID     string `json:"virtualMachineId"`
+	SourceResourceID     string `json:"sourceResourceId"`
+	PolicyID             string `json:"policyId"`
+}
+
+func dataSourceArmRecoveryServicesProtectableItems() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmRecoveryServicesProtectableItemsRead,
+
+		Schema: map[string]*schema.Schema{
+			"recovery_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"backup_management_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(backup.ManagementTypeAzureIaasVM),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(backup.ManagementTypeAzureIaasVM),
+					string(backup.ManagementTypeAzureStorage),
+					string(backup.ManagementTypeAzureWorkload),
+				}, false),
+			},
+
+			"workload_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"protectable_items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"workload_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"protection_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"source_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"protected_items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"workload_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"protection_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"source_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"backup_policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmRecoveryServicesProtectableItemsRead(d *schema.ResourceData, meta interface{}) error {
+	protectableClient := meta.(*ArmClient).recoveryServicesProtectableItemsClient
+	protectedClient := meta.(*ArmClient).recoveryServicesBackupProtectedItemsClient
+	vaultsClient := meta.(*ArmClient).recoveryServicesVaultsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	vaultName := d.Get("recovery_vault_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	managementType := d.Get("backup_management_type").(string)
+	workloadType := d.Get("workload_type").(string)
+
+	vault, err := vaultsClient.Get(ctx, resourceGroup, vaultName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	if vault.ID == nil {
+		return fmt.Errorf("Error retrieving Recovery Service Vault %q (Resource Group %q): `id` was nil", vaultName, resourceGroup)
+	}
+
+	filter := fmt.Sprintf("backupManagementType eq '%s'", managementType)
+	if workloadType != "" {
+		filter = fmt.Sprintf("%s and workloadType eq '%s'", filter, workloadType)
+	}
+
+	protectableItems := make([]interface{}, 0)
+	protectableIter, err := protectableClient.ListComplete(ctx, vaultName, resourceGroup, filter, "")
+	if err != nil {
+		return fmt.Errorf("Error listing Protectable Items in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+	for protectableIter.NotDone() {
+		v := protectableIter.Value()
+
+		item, err := parseRecoveryServicesBackupItem(v.Properties)
+		if err != nil {
+			return fmt.Errorf("Error parsing Protectable Item: %+v", err)
+		}
+
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		protectableItems = append(protectableItems, map[string]interface{}{
+			"name":               name,
+			"friendly_name":      item.FriendlyName,
+			"workload_type":      item.WorkloadType,
+			"protection_state":   item.ProtectionState,
+			"source_resource_id": item.VirtualMachineID,
+		})
+
+		if err := protectableIter.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Protectable Items in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		}
+	}
+
+	protectedItems := make([]interface{}, 0)
+	protectedIter, err := protectedClient.ListComplete(ctx, vaultName, resourceGroup, filter, "")
+	if err != nil {
+		return fmt.Errorf("Error listing Protected Items in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+	for protectedIter.NotDone() {
+		v := protectedIter.Value()
+
+		item, err := parseRecoveryServicesBackupItem(v.Properties)
+		if err != nil {
+			return fmt.Errorf("Error parsing Protected Item: %+v", err)
+		}
+
+		id := ""
+		if v.ID != nil {
+			id = *v.ID
+		}
+
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		sourceResourceId := item.SourceResourceID
+		if sourceResourceId == "" {
+			sourceResourceId = item.VirtualMachineID
+		}
+
+		protectedItems = append(protectedItems, map[string]interface{}{
+			"id":                 id,
+			"name":               name,
+			"friendly_name":      item.FriendlyName,
+			"workload_type":      item.WorkloadType,
+			"protection_state":   item.ProtectionState,
+			"source_resource_id": sourceResourceId,
+			"backup_policy_id":   item.PolicyID,
+		})
+
+		if err := protectedIter.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Protected Items in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/backupProtectableItems/%s", *vault.ID, managementType))
+
+	if err := d.Set("protectable_items", protectableItems); err != nil {
+		return fmt.Errorf("Error setting `protectable_items`: %+v", err)
+	}
+
+	if err := d.Set("protected_items", protectedItems); err != nil {
+		return fmt.Errorf("Error setting `protected_items`: %+v", err)
+	}
+
+	return nil
+}
+
+// parseRecoveryServicesBackupItem returns the common properties of a Protectable/Protected Item - since there's a
+// different type for each kind of workload, this round-trips through the JSON representation to avoid switching on each
+func parseRecoveryServicesBackupItem(input interface{}) (*recoveryServicesBackupItem, error) {
+	item := recoveryServicesBackupItem{}
+	if input == nil {
+		return &item, nil
+	}
+
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(raw, &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
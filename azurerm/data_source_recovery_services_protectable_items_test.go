@@ -0,0 +1,89 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseRecoveryServicesBackupItem(t *testing.T) {
+	vmId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1"
+	policyId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/backupPolicies/policy1"
+
+	protected, err := parseRecoveryServicesBackupItem(backup.AzureIaaSComputeVMProtectedItem{
+		FriendlyName:     utils.String("vm1"),
+		VirtualMachineID: utils.String(vmId),
+		SourceResourceID: utils.String(vmId),
+		PolicyID:         utils.String(policyId),
+		ProtectionState:  backup.ProtectionStateProtected,
+		WorkloadType:     backup.DataSourceTypeVM,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error parsing a Protected Item but got: %+v", err)
+	}
+
+	if protected.FriendlyName != "vm1" || protected.SourceResourceID != vmId || protected.PolicyID != policyId {
+		t.Fatalf("Unexpected Protected Item: %+v", protected)
+	}
+	if protected.ProtectionState != string(backup.ProtectionStateProtected) || protected.WorkloadType != string(backup.DataSourceTypeVM) {
+		t.Fatalf("Unexpected Protected Item: %+v", protected)
+	}
+
+	protectable, err := parseRecoveryServicesBackupItem(backup.AzureIaaSComputeVMProtectableItem{
+		FriendlyName:     utils.String("vm1"),
+		VirtualMachineID: utils.String(vmId),
+		WorkloadType:     utils.String("VM"),
+		ProtectionState:  backup.ProtectionStatusNotProtected,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error parsing a Protectable Item but got: %+v", err)
+	}
+
+	if protectable.VirtualMachineID != vmId || protectable.ProtectionState != string(backup.ProtectionStatusNotProtected) {
+		t.Fatalf("Unexpected Protectable Item: %+v", protectable)
+	}
+
+	empty, err := parseRecoveryServicesBackupItem(nil)
+	if err != nil || empty == nil {
+		t.Fatalf("Expected an empty item for nil input but got %+v (error %+v)", empty, err)
+	}
+}
+
+func TestAccDataSourceAzureRMRecoveryServicesProtectableItems_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_recovery_services_protectable_items.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMRecoveryServicesProtectableItems_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "protected_items.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "protected_items.0.source_resource_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "protected_items.0.backup_policy_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "protected_items.0.workload_type", "VM"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMRecoveryServicesProtectableItems_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_recovery_services_protectable_items" "test" {
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workload_type       = "VM"
+
+  depends_on = ["azurerm_recovery_services_protected_vm.test"]
+}
+`, testAccAzureRMRecoveryServicesProtectedVm_basic(rInt, location))
+}
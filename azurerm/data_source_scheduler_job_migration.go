@@ -0,0 +1,261 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmSchedulerJobMigration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSchedulerJobMigrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"job_collection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"recurrence_frequency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"recurrence_interval": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"action_http_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"action_http_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"action_http_body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"action_http_headers": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"workflow_definition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmSchedulerJobMigrationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).schedulerJobsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	jobCollection := d.Get("job_collection_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	job, err := client.Get(ctx, resourceGroup, jobCollection, name) //nolint: megacheck
+	if err != nil {
+		if utils.ResponseWasNotFound(job.Response) {
+			return fmt.Errorf("Error: Scheduler Job %q (Job Collection %q / Resource Group %q) was not found", name, jobCollection, resourceGroup)
+		}
+
+		return fmt.Errorf("Error making Read request on Scheduler Job %q (Job Collection %q / Resource Group %q): %+v", name, jobCollection, resourceGroup, err)
+	}
+
+	props := job.Properties
+	if props == nil || props.Action == nil || props.Action.Request == nil {
+		return fmt.Errorf("Error: Scheduler Job %q (Job Collection %q / Resource Group %q) has no Web Action - only Scheduler Jobs with a Web Action can be migrated to a Logic App", name, jobCollection, resourceGroup)
+	}
+
+	actionType := props.Action.Type
+	if !strings.EqualFold(string(actionType), string(scheduler.HTTP)) && !strings.EqualFold(string(actionType), string(scheduler.HTTPS)) {
+		return fmt.Errorf("Error: Scheduler Job %q (Job Collection %q / Resource Group %q) has an Action of type %q - only Web Actions can be migrated to a Logic App", name, jobCollection, resourceGroup, string(actionType))
+	}
+
+	definition := schedulerJobMigrationWorkflowDefinition(props)
+	definitionJson, err := structure.FlattenJsonToString(definition)
+	if err != nil {
+		return fmt.Errorf("Error serializing the Logic App Workflow Definition for Scheduler Job %q: %+v", name, err)
+	}
+
+	d.SetId(*job.ID)
+
+	request := props.Action.Request
+	if request.Method != nil {
+		d.Set("action_http_method", strings.ToUpper(*request.Method))
+	}
+	d.Set("action_http_uri", request.URI)
+	d.Set("action_http_body", request.Body)
+
+	headers := make(map[string]interface{})
+	for k, v := range request.Headers {
+		if v != nil {
+			headers[k] = *v
+		}
+	}
+	if err := d.Set("action_http_headers", headers); err != nil {
+		return fmt.Errorf("Error setting `action_http_headers`: %+v", err)
+	}
+
+	// a Scheduler Job without a Recurrence only runs once, in which case there's no equivalent Recurrence Trigger
+	if recurrence := props.Recurrence; recurrence != nil {
+		d.Set("recurrence_frequency", schedulerJobMigrationFrequency(recurrence.Frequency))
+		if recurrence.Interval != nil {
+			d.Set("recurrence_interval", int(*recurrence.Interval))
+		}
+	}
+
+	d.Set("workflow_definition", definitionJson)
+
+	return nil
+}
+
+// schedulerJobMigrationWorkflowDefinition builds the equivalent Logic App Workflow Definition for a Scheduler Job,
+// comprising a Recurrence Trigger and a HTTP Action (with the Jobs' Retry Policy)
+func schedulerJobMigrationWorkflowDefinition(props *scheduler.JobProperties) map[string]interface{} {
+	// a Scheduler Job without a Recurrence only runs once - Logic Apps have no equivalent, so this
+	// falls back to a daily Recurrence which should be disabled (or removed) once it's run
+	recurrence := map[string]interface{}{
+		"frequency": "Day",
+		"interval":  1,
+	}
+
+	if props.StartTime != nil {
+		recurrence["startTime"] = props.StartTime.Format("2006-01-02T15:04:05Z")
+	}
+
+	if v := props.Recurrence; v != nil {
+		if v.Frequency != "" {
+			recurrence["frequency"] = schedulerJobMigrationFrequency(v.Frequency)
+		}
+		if v.Interval != nil {
+			recurrence["interval"] = int(*v.Interval)
+		}
+		if v.EndTime != nil {
+			recurrence["endTime"] = v.EndTime.Format("2006-01-02T15:04:05Z")
+		}
+
+		if s := v.Schedule; s != nil {
+			schedule := make(map[string]interface{})
+			if s.WeekDays != nil {
+				weekDays := make([]interface{}, 0)
+				for _, day := range *s.WeekDays {
+					weekDays = append(weekDays, string(day))
+				}
+				schedule["weekDays"] = weekDays
+			}
+			if s.Hours != nil {
+				schedule["hours"] = *s.Hours
+			}
+			if s.Minutes != nil {
+				schedule["minutes"] = *s.Minutes
+			}
+			if s.MonthDays != nil {
+				schedule["monthDays"] = *s.MonthDays
+			}
+
+			if len(schedule) > 0 {
+				recurrence["schedule"] = schedule
+			}
+		}
+	}
+
+	request := props.Action.Request
+	inputs := make(map[string]interface{})
+	if request.Method != nil {
+		inputs["method"] = strings.ToUpper(*request.Method)
+	}
+	if request.URI != nil {
+		inputs["uri"] = *request.URI
+	}
+
+	if len(request.Headers) > 0 {
+		headers := make(map[string]interface{})
+		for k, v := range request.Headers {
+			if v != nil {
+				headers[k] = *v
+			}
+		}
+		inputs["headers"] = headers
+	}
+
+	if request.Body != nil && *request.Body != "" {
+		inputs["body"] = *request.Body
+	}
+
+	action := map[string]interface{}{
+		"type":     "Http",
+		"inputs":   inputs,
+		"runAfter": map[string]interface{}{},
+	}
+
+	// Scheduler retries 4 times at 30 second intervals by default, whereas Logic Apps use an exponential policy
+	if retry := props.Action.RetryPolicy; retry != nil {
+		if strings.EqualFold(string(retry.RetryType), string(scheduler.None)) {
+			action["retryPolicy"] = map[string]interface{}{
+				"type": "none",
+			}
+		} else {
+			retryPolicy := map[string]interface{}{
+				"type": "fixed",
+			}
+			if retry.RetryCount != nil {
+				retryPolicy["count"] = int(*retry.RetryCount)
+			}
+			if retry.RetryInterval != nil {
+				retryPolicy["interval"] = *retry.RetryInterval
+			}
+			action["retryPolicy"] = retryPolicy
+		}
+	}
+
+	return map[string]interface{}{
+		"$schema":        "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#",
+		"contentVersion": "1.0.0.0",
+		"triggers": map[string]interface{}{
+			"Recurrence": map[string]interface{}{
+				"type":       "Recurrence",
+				"recurrence": recurrence,
+			},
+		},
+		"actions": map[string]interface{}{
+			"HTTP": action,
+		},
+		"outputs": map[string]interface{}{},
+	}
+}
+
+// schedulerJobMigrationFrequency returns the Logic App Recurrence Frequency for the Scheduler Job Frequency,
+// since the API returns these in whichever casing they were submitted in
+func schedulerJobMigrationFrequency(input scheduler.RecurrenceFrequency) string {
+	for _, v := range scheduler.PossibleRecurrenceFrequencyValues() {
+		if strings.EqualFold(string(v), string(input)) {
+			return string(v)
+		}
+	}
+
+	return string(input)
+}
@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMSchedulerJobMigration_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_scheduler_job_migration.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSchedulerJobMigration_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "recurrence_frequency", "Minute"),
+					resource.TestCheckResourceAttr(dataSourceName, "recurrence_interval", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "action_http_method", "GET"),
+					resource.TestCheckResourceAttr(dataSourceName, "action_http_uri", "https://example.com"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "workflow_definition"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSchedulerJobMigration_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_scheduler_job_migration" "test" {
+  name                = "${azurerm_scheduler_job.test.name}"
+  job_collection_name = "${azurerm_scheduler_job.test.job_collection_name}"
+  resource_group_name = "${azurerm_scheduler_job.test.resource_group_name}"
+}
+`, testAccAzureRMSchedulerJob_web_recurring(rInt, location))
+}
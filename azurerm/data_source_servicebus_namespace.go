@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -59,6 +60,11 @@ func dataSourceArmServiceBusNamespace() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"fully_qualified_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tagsForDataSourceSchema(),
 		},
 	}
@@ -91,6 +97,16 @@ func dataSourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface
 		d.Set("capacity", sku.Capacity)
 	}
 
+	fullyQualifiedNamespace := ""
+	if props := resp.SBNamespaceProperties; props != nil && props.ServiceBusEndpoint != nil {
+		fqn, err := azure.ServiceBusFullyQualifiedNamespace(*props.ServiceBusEndpoint)
+		if err != nil {
+			return err
+		}
+		fullyQualifiedNamespace = fqn
+	}
+	d.Set("fully_qualified_namespace", fullyQualifiedNamespace)
+
 	keys, err := client.ListKeys(ctx, resourceGroup, name, serviceBusNamespaceDefaultAuthorizationRule)
 	if err != nil {
 		log.Printf("[WARN] Unable to List default keys for Namespace %q (Resource Group %q): %+v", name, resourceGroup, err)
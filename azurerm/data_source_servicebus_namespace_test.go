@@ -29,6 +29,7 @@ func TestAccDataSourceAzureRMServiceBusNamespace_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "default_secondary_connection_string"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "default_primary_key"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "default_secondary_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "fully_qualified_namespace"),
 				),
 			},
 		},
@@ -56,6 +57,7 @@ func TestAccDataSourceAzureRMServiceBusNamespace_premium(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "default_secondary_connection_string"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "default_primary_key"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "default_secondary_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "fully_qualified_namespace"),
 				),
 			},
 		},
@@ -0,0 +1,52 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMServiceBusQueueAuthorizationRule_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_servicebus_queue_authorization_rule.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusQueueAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMServiceBusQueueAuthorizationRule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "namespace_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "queue_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "listen", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "send", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "manage", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secondary_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secondary_connection_string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMServiceBusQueueAuthorizationRule_basic(rInt int, location string) string {
+	template := testAccAzureRMServiceBusQueueAuthorizationRule_base(rInt, location, true, true, false)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_servicebus_queue_authorization_rule" "test" {
+  name                = "${azurerm_servicebus_queue_authorization_rule.test.name}"
+  namespace_name      = "${azurerm_servicebus_queue_authorization_rule.test.namespace_name}"
+  queue_name       = "${azurerm_servicebus_queue_authorization_rule.test.queue_name}"
+  resource_group_name = "${azurerm_servicebus_queue_authorization_rule.test.resource_group_name}"
+}
+`, template)
+}
@@ -0,0 +1,52 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMServiceBusTopicAuthorizationRule_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_servicebus_topic_authorization_rule.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusTopicAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMServiceBusTopicAuthorizationRule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "namespace_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "topic_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "listen", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "send", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "manage", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secondary_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secondary_connection_string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMServiceBusTopicAuthorizationRule_basic(rInt int, location string) string {
+	template := testAccAzureRMServiceBusTopicAuthorizationRule_base(rInt, location, true, true, false)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_servicebus_topic_authorization_rule" "test" {
+  name                = "${azurerm_servicebus_topic_authorization_rule.test.name}"
+  namespace_name      = "${azurerm_servicebus_topic_authorization_rule.test.namespace_name}"
+  topic_name       = "${azurerm_servicebus_topic_authorization_rule.test.topic_name}"
+  resource_group_name = "${azurerm_servicebus_topic_authorization_rule.test.resource_group_name}"
+}
+`, template)
+}
@@ -0,0 +1,161 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmStorageBlobs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageBlobsRead,
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"storage_container_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"blobs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"content_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"content_md5": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmStorageBlobsRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	storageAccountName := d.Get("storage_account_name").(string)
+	containerName := d.Get("storage_container_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	prefix := d.Get("prefix").(string)
+
+	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Error: Storage Account %q (Resource Group %q) was not found", storageAccountName, resourceGroup)
+	}
+
+	container := blobClient.GetContainerReference(containerName)
+
+	blobs := make([]interface{}, 0)
+	listParams := storage.ListBlobsParameters{
+		Prefix: prefix,
+		Include: &storage.IncludeBlobDataset{
+			Metadata: true,
+		},
+		Timeout: 90,
+	}
+
+	for {
+		resp, err := container.ListBlobs(listParams)
+		if err != nil {
+			return fmt.Errorf("Error listing Blobs in Container %q (Storage Account %q / Resource Group %q): %+v", containerName, storageAccountName, resourceGroup, err)
+		}
+
+		for _, b := range resp.Blobs {
+			metadata := make(map[string]interface{})
+			for k, v := range b.Metadata {
+				metadata[k] = v
+			}
+
+			lastModified := ""
+			if t := time.Time(b.Properties.LastModified); !t.IsZero() {
+				lastModified = t.Format(time.RFC3339)
+			}
+
+			blobs = append(blobs, map[string]interface{}{
+				"name":          b.Name,
+				"url":           b.GetURL(),
+				"type":          string(b.Properties.BlobType),
+				"size":          int(b.Properties.ContentLength),
+				"content_type":  b.Properties.ContentType,
+				"content_md5":   b.Properties.ContentMD5,
+				"last_modified": lastModified,
+				"metadata":      metadata,
+			})
+		}
+
+		if resp.NextMarker == "" {
+			break
+		}
+
+		listParams.Marker = resp.NextMarker
+	}
+
+	d.SetId(fmt.Sprintf("https://%s.blob.%s/%s?prefix=%s", storageAccountName, armClient.environment.StorageEndpointSuffix, containerName, prefix))
+
+	if err := d.Set("blobs", blobs); err != nil {
+		return fmt.Errorf("Error setting `blobs`: %+v", err)
+	}
+
+	return nil
+}
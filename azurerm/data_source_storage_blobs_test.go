@@ -0,0 +1,85 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMStorageBlobs_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_blobs.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageBlobs_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "blobs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "blobs.0.name", "disks/first.vhd"),
+					resource.TestCheckResourceAttr(dataSourceName, "blobs.0.type", "PageBlob"),
+					resource.TestCheckResourceAttr(dataSourceName, "blobs.0.size", "5120"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "blobs.0.url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStorageBlobs_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "first" {
+  name                   = "disks/first.vhd"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "page"
+  size                   = 5120
+}
+
+resource "azurerm_storage_blob" "second" {
+  name                   = "images/second.vhd"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "page"
+  size                   = 5120
+}
+
+data "azurerm_storage_blobs" "test" {
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  prefix                 = "disks/"
+
+  depends_on = ["azurerm_storage_blob.first", "azurerm_storage_blob.second"]
+}
+`, rInt, location, rString)
+}
@@ -0,0 +1,146 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmStorageContainers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageContainersRead,
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"containers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"container_access_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"lease_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"lease_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmStorageContainersRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	storageAccountName := d.Get("storage_account_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	prefix := d.Get("prefix").(string)
+
+	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Error: Storage Account %q (Resource Group %q) was not found", storageAccountName, resourceGroup)
+	}
+
+	endpoint := fmt.Sprintf("https://%s.blob.%s", storageAccountName, armClient.environment.StorageEndpointSuffix)
+
+	containers := make([]interface{}, 0)
+	listParams := storage.ListContainersParameters{
+		Prefix:  prefix,
+		Include: "metadata",
+		Timeout: 90,
+	}
+
+	for {
+		resp, err := blobClient.ListContainers(listParams)
+		if err != nil {
+			return fmt.Errorf("Error listing Containers in Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+		}
+
+		for _, c := range resp.Containers {
+			// for historical reasons, "private" is an empty string in the API
+			accessType := string(c.Properties.PublicAccess)
+			if c.Properties.PublicAccess == storage.ContainerAccessTypePrivate {
+				accessType = "private"
+			}
+
+			metadata := make(map[string]interface{})
+			for k, v := range c.Metadata {
+				metadata[k] = v
+			}
+
+			containers = append(containers, map[string]interface{}{
+				"name":                  c.Name,
+				"id":                    fmt.Sprintf("%s/%s", endpoint, c.Name),
+				"container_access_type": accessType,
+				"metadata":              metadata,
+				"last_modified":         c.Properties.LastModified,
+				"lease_status":          c.Properties.LeaseStatus,
+				"lease_state":           c.Properties.LeaseState,
+			})
+		}
+
+		if resp.NextMarker == "" {
+			break
+		}
+
+		listParams.Marker = resp.NextMarker
+	}
+
+	d.SetId(fmt.Sprintf("%s/?prefix=%s", endpoint, prefix))
+
+	if err := d.Set("containers", containers); err != nil {
+		return fmt.Errorf("Error setting `containers`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMStorageContainers_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_containers.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageContainers_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "containers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "containers.0.name", "vhds-first"),
+					resource.TestCheckResourceAttr(dataSourceName, "containers.0.container_access_type", "private"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "containers.0.last_modified"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStorageContainers_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "first" {
+  name                  = "vhds-first"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_container" "second" {
+  name                  = "images"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "blob"
+}
+
+data "azurerm_storage_containers" "test" {
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  prefix               = "vhds-"
+
+  depends_on = ["azurerm_storage_container.first", "azurerm_storage_container.second"]
+}
+`, rInt, location, rString)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
@@ -40,16 +41,34 @@ func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) er
 	displayNamePrefix := strings.ToLower(d.Get("display_name_prefix").(string))
 	displayNameContains := strings.ToLower(d.Get("display_name_contains").(string))
 
-	//ListComplete returns an iterator struct
-	results, err := subClient.ListComplete(ctx)
+	// the Subscriptions available don't change during a plan/apply, so these are cached
+	raw, err := armClient.listCache.get(func() (interface{}, error) {
+		//ListComplete returns an iterator struct
+		results, err := subClient.ListComplete(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing subscriptions: %+v", err)
+		}
+
+		values := make([]subscriptions.Subscription, 0)
+		for results.NotDone() {
+			values = append(values, results.Value())
+
+			if err = results.NextWithContext(ctx); err != nil {
+				return nil, fmt.Errorf("Error going to next subscriptions value: %+v", err)
+			}
+		}
+
+		return values, nil
+	}, "subscriptions", armClient.tenantId)
 	if err != nil {
-		return fmt.Errorf("Error listing subscriptions: %+v", err)
+		return err
 	}
 
+	values := raw.([]subscriptions.Subscription)
+
 	//iterate across each subscriptions and append them to slice
 	subscriptions := make([]map[string]interface{}, 0)
-	for results.NotDone() {
-		val := results.Value()
+	for _, val := range values {
 
 		s := make(map[string]interface{})
 
@@ -71,10 +90,6 @@ func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) er
 			s["spending_limit"] = string(policies.SpendingLimit)
 		}
 
-		if err = results.Next(); err != nil {
-			return fmt.Errorf("Error going to next subscriptions value: %+v", err)
-		}
-
 		//check if the display name prefix matches the given input
 		if displayNamePrefix != "" {
 			if !strings.HasPrefix(strings.ToLower(s["display_name"].(string)), displayNamePrefix) {
@@ -0,0 +1,171 @@
+package azurerm
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmVirtualMachineSizes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualMachineSizesRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": locationSchema(),
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"sizes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"memory_in_gb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+
+						"max_data_disk_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"premium_io_supported": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmVirtualMachineSizesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	ctx := meta.(*ArmClient).StopContext
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	name := d.Get("name").(string)
+	zone := d.Get("zone").(string)
+
+	skus, err := listComputeResourceSkusInLocation(ctx, client, location, "virtualMachines")
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(skus, func(i, j int) bool {
+		return *skus[i].Name < *skus[j].Name
+	})
+
+	sizes := make([]interface{}, 0)
+	for _, sku := range skus {
+		if sku.Name == nil {
+			continue
+		}
+
+		if name != "" && !strings.EqualFold(*sku.Name, name) {
+			continue
+		}
+
+		zones := computeResourceSkuZonesInLocation(sku, location)
+		if zone != "" && !sliceContainsValue(zones, zone) {
+			continue
+		}
+
+		sizes = append(sizes, flattenVirtualMachineSizeResourceSku(sku, zones))
+	}
+
+	// when a specific size is requested, failing here surfaces that it's unavailable before anything is provisioned
+	if name != "" && len(sizes) == 0 {
+		if zone != "" {
+			return fmt.Errorf("Error: Virtual Machine Size %q is not available in Zone %q of Location %q", name, zone, location)
+		}
+
+		return fmt.Errorf("Error: Virtual Machine Size %q is not available in Location %q", name, location)
+	}
+
+	d.SetId(fmt.Sprintf("virtualMachineSizes-%s", location))
+	if err := d.Set("sizes", sizes); err != nil {
+		return fmt.Errorf("Error setting `sizes`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenVirtualMachineSizeResourceSku(sku compute.ResourceSku, zones []string) map[string]interface{} {
+	output := map[string]interface{}{
+		"name":  *sku.Name,
+		"zones": zones,
+	}
+
+	if sku.Family != nil {
+		output["family"] = *sku.Family
+	}
+
+	if sku.Capabilities == nil {
+		return output
+	}
+
+	for _, capability := range *sku.Capabilities {
+		if capability.Name == nil || capability.Value == nil {
+			continue
+		}
+
+		value := *capability.Value
+		switch *capability.Name {
+		case "vCPUs":
+			if v, err := strconv.Atoi(value); err == nil {
+				output["vcpus"] = v
+			}
+		case "MemoryGB":
+			if v, err := strconv.ParseFloat(value, 64); err == nil {
+				output["memory_in_gb"] = v
+			}
+		case "MaxDataDiskCount":
+			if v, err := strconv.Atoi(value); err == nil {
+				output["max_data_disk_count"] = v
+			}
+		case "PremiumIO":
+			output["premium_io_supported"] = strings.EqualFold(value, "True")
+		}
+	}
+
+	return output
+}
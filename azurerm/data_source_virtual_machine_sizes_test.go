@@ -0,0 +1,79 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVirtualMachineSizes_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_machine_sizes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVirtualMachineSizes_basic(testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "sizes.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sizes.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sizes.0.vcpus"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMVirtualMachineSizes_specific(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_machine_sizes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVirtualMachineSizes_specific(testLocation(), "Standard_DS2_v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "sizes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "sizes.0.name", "Standard_DS2_v2"),
+					resource.TestCheckResourceAttr(dataSourceName, "sizes.0.vcpus", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "sizes.0.memory_in_gb", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "sizes.0.premium_io_supported", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMVirtualMachineSizes_unavailable(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAzureRMVirtualMachineSizes_specific(testLocation(), "Standard_Imaginary_v1"),
+				ExpectError: regexp.MustCompile("is not available in Location"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualMachineSizes_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_virtual_machine_sizes" "test" {
+  location = "%s"
+}
+`, location)
+}
+
+func testAccDataSourceAzureRMVirtualMachineSizes_specific(location string, name string) string {
+	return fmt.Sprintf(`
+data "azurerm_virtual_machine_sizes" "test" {
+  location = "%s"
+  name     = "%s"
+}
+`, location, name)
+}
@@ -0,0 +1,51 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	eventualConsistencyStatePending = "Pending"
+	eventualConsistencyStateStable  = "Stable"
+)
+
+// waitForEventualConsistency waits until the `check` function has reported the change as visible for several
+// consecutive polls. Some changes (such as Role Assignments and Key Vault Access Policies) are replicated after the
+// API has returned - and as such fail when they're used immediately by another resource. The wait is bounded by
+// the `eventual_consistency_timeout_in_minutes` Provider argument, and is skipped when that's set to 0.
+func waitForEventualConsistency(client *ArmClient, description string, check func() (bool, error)) error {
+	if client.eventualConsistencyTimeout <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting for %s to become consistent", description)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventualConsistencyStatePending},
+		Target:  []string{eventualConsistencyStateStable},
+		Refresh: func() (interface{}, string, error) {
+			visible, err := check()
+			if err != nil {
+				return nil, "", err
+			}
+
+			if !visible {
+				return eventualConsistencyStatePending, eventualConsistencyStatePending, nil
+			}
+
+			return eventualConsistencyStateStable, eventualConsistencyStateStable, nil
+		},
+		Timeout:                   client.eventualConsistencyTimeout,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s to become consistent: %+v", description, err)
+	}
+
+	return nil
+}
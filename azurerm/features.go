@@ -0,0 +1,127 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// features contains the behavioural toggles which can be configured within the Provider's `features` block.
+// Each Service has its own sub-block (and struct) - new toggles should be added there, with a default which
+// preserves the existing behaviour, rather than as an ad-hoc Environment Variable
+type features struct {
+	ResourceGroup  resourceGroupFeatures
+	VirtualMachine virtualMachineFeatures
+}
+
+type resourceGroupFeatures struct {
+	PreventDeletionIfContainsResources bool
+}
+
+type virtualMachineFeatures struct {
+	DeleteOSDiskOnDeletion            bool
+	DeleteDataDisksOnDeletion         bool
+	DeleteNetworkInterfacesOnDeletion bool
+}
+
+func defaultFeatures() features {
+	return features{
+		ResourceGroup: resourceGroupFeatures{
+			PreventDeletionIfContainsResources: false,
+		},
+		VirtualMachine: virtualMachineFeatures{
+			DeleteOSDiskOnDeletion:            false,
+			DeleteDataDisksOnDeletion:         false,
+			DeleteNetworkInterfacesOnDeletion: false,
+		},
+	}
+}
+
+func schemaFeatures() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_group": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"prevent_deletion_if_contains_resources": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"virtual_machine": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete_os_disk_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"delete_data_disks_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"delete_network_interfaces_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandFeatures(input []interface{}) features {
+	output := defaultFeatures()
+
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if raw, ok := val["resource_group"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			resourceGroup := items[0].(map[string]interface{})
+			if v, ok := resourceGroup["prevent_deletion_if_contains_resources"]; ok {
+				output.ResourceGroup.PreventDeletionIfContainsResources = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["virtual_machine"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			virtualMachine := items[0].(map[string]interface{})
+			if v, ok := virtualMachine["delete_os_disk_on_deletion"]; ok {
+				output.VirtualMachine.DeleteOSDiskOnDeletion = v.(bool)
+			}
+			if v, ok := virtualMachine["delete_data_disks_on_deletion"]; ok {
+				output.VirtualMachine.DeleteDataDisksOnDeletion = v.(bool)
+			}
+			if v, ok := virtualMachine["delete_network_interfaces_on_deletion"]; ok {
+				output.VirtualMachine.DeleteNetworkInterfacesOnDeletion = v.(bool)
+			}
+		}
+	}
+
+	return output
+}
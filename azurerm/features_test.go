@@ -0,0 +1,92 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandFeatures(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected features
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: defaultFeatures(),
+		},
+		{
+			Name: "Empty Virtual Machine Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine": []interface{}{},
+				},
+			},
+			Expected: defaultFeatures(),
+		},
+		{
+			Name: "Delete OS Disk",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": true,
+						},
+					},
+				},
+			},
+			Expected: features{
+				VirtualMachine: virtualMachineFeatures{
+					DeleteOSDiskOnDeletion: true,
+				},
+			},
+		},
+		{
+			Name: "Delete Data Disks and Network Interfaces",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion":            false,
+							"delete_data_disks_on_deletion":         true,
+							"delete_network_interfaces_on_deletion": true,
+						},
+					},
+				},
+			},
+			Expected: features{
+				VirtualMachine: virtualMachineFeatures{
+					DeleteDataDisksOnDeletion:         true,
+					DeleteNetworkInterfacesOnDeletion: true,
+				},
+			},
+		},
+		{
+			Name: "Prevent Deletion of Resource Groups containing Resources",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources": true,
+						},
+					},
+				},
+			},
+			Expected: features{
+				ResourceGroup: resourceGroupFeatures{
+					PreventDeletionIfContainsResources: true,
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := expandFeatures(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -54,24 +54,7 @@ func SchemaAppServiceSiteConfig() *schema.Schema {
 					Default:  false,
 				},
 
-				"ip_restriction": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Computed: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"ip_address": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							"subnet_mask": {
-								Type:     schema.TypeString,
-								Optional: true,
-								Default:  "255.255.255.255",
-							},
-						},
-					},
-				},
+				"ip_restriction": schemaAppServiceIPRestriction(),
 
 				"java_version": {
 					Type:     schema.TypeString,
@@ -281,29 +264,7 @@ func ExpandAppServiceSiteConfig(input interface{}) web.SiteConfig {
 	}
 
 	if v, ok := config["ip_restriction"]; ok {
-		ipSecurityRestrictions := v.([]interface{})
-		restrictions := make([]web.IPSecurityRestriction, 0)
-		for _, ipSecurityRestriction := range ipSecurityRestrictions {
-			restriction := ipSecurityRestriction.(map[string]interface{})
-
-			ipAddress := restriction["ip_address"].(string)
-			mask := restriction["subnet_mask"].(string)
-			// the 2018-02-01 API expects a blank subnet mask and an IP address in CIDR format: a.b.c.d/x
-			// so translate the IP and mask if necessary
-			restrictionMask := ""
-			cidrAddress := ipAddress
-			if mask != "" {
-				ipNet := net.IPNet{IP: net.ParseIP(ipAddress), Mask: net.IPMask(net.ParseIP(mask))}
-				cidrAddress = ipNet.String()
-			} else if !strings.Contains(ipAddress, "/") {
-				cidrAddress += "/32"
-			}
-
-			restrictions = append(restrictions, web.IPSecurityRestriction{
-				IPAddress:  &cidrAddress,
-				SubnetMask: &restrictionMask,
-			})
-		}
+		restrictions := expandAppServiceIPRestrictions(v.([]interface{}))
 		siteConfig.IPSecurityRestrictions = &restrictions
 	}
 
@@ -405,28 +366,7 @@ func FlattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 		result["http2_enabled"] = *input.HTTP20Enabled
 	}
 
-	restrictions := make([]interface{}, 0)
-	if vs := input.IPSecurityRestrictions; vs != nil {
-		for _, v := range *vs {
-			block := make(map[string]interface{})
-			if ip := v.IPAddress; ip != nil {
-				// the 2018-02-01 API uses CIDR format (a.b.c.d/x), so translate that back to IP and mask
-				if strings.Contains(*ip, "/") {
-					ipAddr, ipNet, _ := net.ParseCIDR(*ip)
-					block["ip_address"] = ipAddr.String()
-					mask := net.IP(ipNet.Mask)
-					block["subnet_mask"] = mask.String()
-				} else {
-					block["ip_address"] = *ip
-				}
-			}
-			if subnet := v.SubnetMask; subnet != nil {
-				block["subnet_mask"] = *subnet
-			}
-			restrictions = append(restrictions, block)
-		}
-	}
-	result["ip_restriction"] = restrictions
+	result["ip_restriction"] = flattenAppServiceIPRestrictions(input.IPSecurityRestrictions)
 
 	result["managed_pipeline_mode"] = string(input.ManagedPipelineMode)
 
@@ -468,3 +408,79 @@ func FlattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 
 	return append(results, result)
 }
+
+func schemaAppServiceIPRestriction() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip_address": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"subnet_mask": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "255.255.255.255",
+				},
+			},
+		},
+	}
+}
+
+func expandAppServiceIPRestrictions(input []interface{}) []web.IPSecurityRestriction {
+	restrictions := make([]web.IPSecurityRestriction, 0)
+	for _, ipSecurityRestriction := range input {
+		restriction := ipSecurityRestriction.(map[string]interface{})
+
+		ipAddress := restriction["ip_address"].(string)
+		mask := restriction["subnet_mask"].(string)
+		// the 2018-02-01 API expects a blank subnet mask and an IP address in CIDR format: a.b.c.d/x
+		// so translate the IP and mask if necessary
+		restrictionMask := ""
+		cidrAddress := ipAddress
+		if mask != "" {
+			ipNet := net.IPNet{IP: net.ParseIP(ipAddress), Mask: net.IPMask(net.ParseIP(mask))}
+			cidrAddress = ipNet.String()
+		} else if !strings.Contains(ipAddress, "/") {
+			cidrAddress += "/32"
+		}
+
+		restrictions = append(restrictions, web.IPSecurityRestriction{
+			IPAddress:  &cidrAddress,
+			SubnetMask: &restrictionMask,
+		})
+	}
+
+	return restrictions
+}
+
+func flattenAppServiceIPRestrictions(input *[]web.IPSecurityRestriction) []interface{} {
+	restrictions := make([]interface{}, 0)
+	if input == nil {
+		return restrictions
+	}
+
+	for _, v := range *input {
+		block := make(map[string]interface{})
+		if ip := v.IPAddress; ip != nil {
+			// the 2018-02-01 API uses CIDR format (a.b.c.d/x), so translate that back to IP and mask
+			if strings.Contains(*ip, "/") {
+				ipAddr, ipNet, _ := net.ParseCIDR(*ip)
+				block["ip_address"] = ipAddr.String()
+				mask := net.IP(ipNet.Mask)
+				block["subnet_mask"] = mask.String()
+			} else {
+				block["ip_address"] = *ip
+			}
+		}
+		if subnet := v.SubnetMask; subnet != nil {
+			block["subnet_mask"] = *subnet
+		}
+		restrictions = append(restrictions, block)
+	}
+
+	return restrictions
+}
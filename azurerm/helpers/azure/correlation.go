@@ -0,0 +1,33 @@
+package azure
+
+import (
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// HeaderCorrelationRequestID is the header used by Azure Resource Manager to correlate requests - the value is
+// recorded against each operation within the Activity Log
+const HeaderCorrelationRequestID = "x-ms-correlation-request-id"
+
+// WithCorrelationRequestID returns a PrepareDecorator which sets the Correlation Request ID returned by `id` on
+// each request - it's looked up per-request so that it can be changed once the API Clients have been configured
+func WithCorrelationRequestID(id func() string) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if v := id(); v != "" {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				r.Header.Set(HeaderCorrelationRequestID, v)
+			}
+
+			return r, nil
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithCorrelationRequestID(t *testing.T) {
+	correlationRequestID := "11111111-1111-1111-1111-111111111111"
+
+	req, err := autorest.Prepare(&http.Request{}, WithCorrelationRequestID(func() string {
+		return correlationRequestID
+	}))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if actual := req.Header.Get(HeaderCorrelationRequestID); actual != correlationRequestID {
+		t.Fatalf("Expected the Correlation Request ID to be %q but got %q", correlationRequestID, actual)
+	}
+
+	req, err = autorest.Prepare(&http.Request{}, WithCorrelationRequestID(func() string {
+		return ""
+	}))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if actual := req.Header.Get(HeaderCorrelationRequestID); actual != "" {
+		t.Fatalf("Expected no Correlation Request ID but got %q", actual)
+	}
+}
@@ -0,0 +1,153 @@
+package azure
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// DatabaseServerThreatDetectionPolicy is the Security Alert Policy for a MariaDB, MySQL or PostgreSQL Server,
+// which is the same shape for each Service but defined in a separate SDK package
+type DatabaseServerThreatDetectionPolicy struct {
+	Enabled                 bool
+	DisabledAlerts          []string
+	EmailAccountAdmins      bool
+	EmailAddresses          []string
+	RetentionDays           int
+	StorageAccountAccessKey string
+	StorageEndpoint         string
+}
+
+func SchemaDatabaseServerThreatDetectionPolicy() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"disabled_alerts": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Set:      schema.HashString,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Sql_Injection",
+							"Sql_Injection_Vulnerability",
+							"Access_Anomaly",
+						}, false),
+					},
+				},
+
+				"email_account_admins": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"email_addresses": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Set:      schema.HashString,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"retention_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"storage_account_access_key": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"storage_endpoint": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+// ExpandDatabaseServerThreatDetectionPolicy returns the Threat Detection Policy defined in the `threat_detection_policy`
+// block - where this block isn't specified the policy is disabled
+func ExpandDatabaseServerThreatDetectionPolicy(input []interface{}) DatabaseServerThreatDetectionPolicy {
+	policy := DatabaseServerThreatDetectionPolicy{
+		DisabledAlerts: make([]string, 0),
+		EmailAddresses: make([]string, 0),
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return policy
+	}
+
+	v := input[0].(map[string]interface{})
+
+	policy.Enabled = v["enabled"].(bool)
+	policy.EmailAccountAdmins = v["email_account_admins"].(bool)
+	policy.RetentionDays = v["retention_days"].(int)
+	policy.StorageAccountAccessKey = v["storage_account_access_key"].(string)
+	policy.StorageEndpoint = v["storage_endpoint"].(string)
+
+	for _, alert := range v["disabled_alerts"].(*schema.Set).List() {
+		policy.DisabledAlerts = append(policy.DisabledAlerts, alert.(string))
+	}
+
+	for _, email := range v["email_addresses"].(*schema.Set).List() {
+		policy.EmailAddresses = append(policy.EmailAddresses, email.(string))
+	}
+
+	return policy
+}
+
+// FlattenDatabaseServerThreatDetectionPolicy flattens the Threat Detection Policy - since the API doesn't return the
+// Storage Account Access Key the existing value (from the state) is used. The API returns a disabled policy when one
+// hasn't been configured - in which case no `threat_detection_policy` block is returned, to avoid a diff.
+func FlattenDatabaseServerThreatDetectionPolicy(input DatabaseServerThreatDetectionPolicy, storageAccountAccessKey string) []interface{} {
+	isDefault := !input.Enabled && !input.EmailAccountAdmins && len(input.DisabledAlerts) == 0 &&
+		len(input.EmailAddresses) == 0 && input.RetentionDays == 0 && input.StorageEndpoint == ""
+	if isDefault {
+		return []interface{}{}
+	}
+
+	disabledAlerts := make([]interface{}, 0)
+	for _, v := range input.DisabledAlerts {
+		if v != "" {
+			disabledAlerts = append(disabledAlerts, v)
+		}
+	}
+
+	emailAddresses := make([]interface{}, 0)
+	for _, v := range input.EmailAddresses {
+		if v != "" {
+			emailAddresses = append(emailAddresses, v)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":                    input.Enabled,
+			"disabled_alerts":            schema.NewSet(schema.HashString, disabledAlerts),
+			"email_account_admins":       input.EmailAccountAdmins,
+			"email_addresses":            schema.NewSet(schema.HashString, emailAddresses),
+			"retention_days":             input.RetentionDays,
+			"storage_account_access_key": storageAccountAccessKey,
+			"storage_endpoint":           input.StorageEndpoint,
+		},
+	}
+}
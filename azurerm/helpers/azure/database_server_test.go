@@ -0,0 +1,70 @@
+package azure
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestExpandDatabaseServerThreatDetectionPolicy(t *testing.T) {
+	disabled := ExpandDatabaseServerThreatDetectionPolicy([]interface{}{})
+	if disabled.Enabled {
+		t.Fatalf("Expected the Policy to be disabled when no block is specified")
+	}
+
+	if disabled.DisabledAlerts == nil || disabled.EmailAddresses == nil {
+		t.Fatalf("Expected the Disabled Alerts and Email Addresses to be empty rather than nil")
+	}
+
+	policy := ExpandDatabaseServerThreatDetectionPolicy([]interface{}{
+		map[string]interface{}{
+			"enabled":                    true,
+			"disabled_alerts":            schema.NewSet(schema.HashString, []interface{}{"Sql_Injection"}),
+			"email_account_admins":       true,
+			"email_addresses":            schema.NewSet(schema.HashString, []interface{}{"security@example.com"}),
+			"retention_days":             15,
+			"storage_account_access_key": "secret",
+			"storage_endpoint":           "https://example.blob.core.windows.net/",
+		},
+	})
+
+	if !policy.Enabled || !policy.EmailAccountAdmins || policy.RetentionDays != 15 {
+		t.Fatalf("Unexpected Policy: %+v", policy)
+	}
+
+	if len(policy.DisabledAlerts) != 1 || policy.DisabledAlerts[0] != "Sql_Injection" {
+		t.Fatalf("Expected the Disabled Alerts to be `Sql_Injection` but got %+v", policy.DisabledAlerts)
+	}
+
+	if len(policy.EmailAddresses) != 1 || policy.EmailAddresses[0] != "security@example.com" {
+		t.Fatalf("Expected the Email Addresses to be `security@example.com` but got %+v", policy.EmailAddresses)
+	}
+}
+
+func TestFlattenDatabaseServerThreatDetectionPolicy(t *testing.T) {
+	if actual := FlattenDatabaseServerThreatDetectionPolicy(DatabaseServerThreatDetectionPolicy{}, ""); len(actual) != 0 {
+		t.Fatalf("Expected the default Policy to be omitted but got %+v", actual)
+	}
+
+	actual := FlattenDatabaseServerThreatDetectionPolicy(DatabaseServerThreatDetectionPolicy{
+		Enabled:        true,
+		DisabledAlerts: []string{""},
+		EmailAddresses: []string{"security@example.com"},
+	}, "secret")
+	if len(actual) != 1 {
+		t.Fatalf("Expected 1 Policy but got %d", len(actual))
+	}
+
+	policy := actual[0].(map[string]interface{})
+	if policy["storage_account_access_key"] != "secret" {
+		t.Fatalf("Expected the Storage Account Access Key to be preserved but got %q", policy["storage_account_access_key"])
+	}
+
+	if alerts := policy["disabled_alerts"].(*schema.Set); alerts.Len() != 0 {
+		t.Fatalf("Expected empty Disabled Alerts to be omitted but got %+v", alerts.List())
+	}
+
+	if emails := policy["email_addresses"].(*schema.Set); emails.Len() != 1 {
+		t.Fatalf("Expected 1 Email Address but got %d", emails.Len())
+	}
+}
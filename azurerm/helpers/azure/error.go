@@ -0,0 +1,255 @@
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Error contains the details of an error returned by Azure Resource Manager, which are otherwise
+// flattened into a single line (and partially JSON-encoded) when the error is formatted
+type Error struct {
+	Operation      string
+	StatusCode     int
+	Code           string
+	Message        string
+	Target         string
+	Details        []ErrorDetail
+	AdditionalInfo []ErrorAdditionalInfo
+	RequestID      string
+	CorrelationID  string
+}
+
+// ErrorDetail contains the details of a nested error, such as an individual validation failure
+type ErrorDetail struct {
+	Code    string
+	Message string
+	Target  string
+}
+
+// ErrorAdditionalInfo contains additional information about an error, such as the Policy which was violated
+type ErrorAdditionalInfo struct {
+	Type string
+	Info map[string]interface{}
+}
+
+// ParseError returns the details of an error returned by Azure Resource Manager - or nil if the error didn't come from ARM
+func ParseError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	result := Error{}
+	if !parseErrorInto(err, &result) {
+		return nil
+	}
+
+	return &result
+}
+
+// FormatError returns a human-readable representation of an error, including the code, details, additional info
+// and request/correlation ID's of errors returned by Azure Resource Manager
+func FormatError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if parsed := ParseError(err); parsed != nil && parsed.Code != "" {
+		return parsed.Error()
+	}
+
+	return err.Error()
+}
+
+func parseErrorInto(err error, result *Error) bool {
+	switch e := err.(type) {
+	case autorest.DetailedError:
+		return parseDetailedErrorInto(e, result)
+	case *autorest.DetailedError:
+		if e == nil {
+			return false
+		}
+		return parseDetailedErrorInto(*e, result)
+	case azure.RequestError:
+		return parseRequestErrorInto(e, result)
+	case *azure.RequestError:
+		if e == nil {
+			return false
+		}
+		return parseRequestErrorInto(*e, result)
+	case azure.ServiceError:
+		parseServiceErrorInto(e, result)
+		return true
+	case *azure.ServiceError:
+		if e == nil {
+			return false
+		}
+		parseServiceErrorInto(*e, result)
+		return true
+	}
+
+	return false
+}
+
+func parseDetailedErrorInto(e autorest.DetailedError, result *Error) bool {
+	if result.Operation == "" && e.PackageType != "" {
+		result.Operation = fmt.Sprintf("%s#%s", e.PackageType, e.Method)
+	}
+
+	if v, ok := e.StatusCode.(int); ok && v != 0 {
+		result.StatusCode = v
+	}
+
+	parseResponseInto(e.Response, result)
+
+	if e.Original != nil {
+		parseErrorInto(e.Original, result)
+	}
+
+	return true
+}
+
+func parseRequestErrorInto(e azure.RequestError, result *Error) bool {
+	parseDetailedErrorInto(e.DetailedError, result)
+
+	if e.RequestID != "" {
+		result.RequestID = e.RequestID
+	}
+
+	if e.ServiceError != nil {
+		parseServiceErrorInto(*e.ServiceError, result)
+	}
+
+	return true
+}
+
+func parseResponseInto(resp *http.Response, result *Error) {
+	if resp == nil {
+		return
+	}
+
+	if result.StatusCode == 0 {
+		result.StatusCode = resp.StatusCode
+	}
+
+	if v := resp.Header.Get("x-ms-request-id"); v != "" {
+		result.RequestID = v
+	}
+
+	if v := resp.Header.Get("x-ms-correlation-request-id"); v != "" {
+		result.CorrelationID = v
+	}
+}
+
+func parseServiceErrorInto(e azure.ServiceError, result *Error) {
+	result.Code = e.Code
+	result.Message = e.Message
+	if e.Target != nil {
+		result.Target = *e.Target
+	}
+
+	for _, detail := range e.Details {
+		result.Details = append(result.Details, ErrorDetail{
+			Code:    stringFromMap(detail, "code"),
+			Message: stringFromMap(detail, "message"),
+			Target:  stringFromMap(detail, "target"),
+		})
+	}
+
+	for _, info := range e.AdditionalInfo {
+		additionalInfo := ErrorAdditionalInfo{
+			Type: stringFromMap(info, "type"),
+		}
+		if v, ok := info["info"].(map[string]interface{}); ok {
+			additionalInfo.Info = v
+		}
+		result.AdditionalInfo = append(result.AdditionalInfo, additionalInfo)
+	}
+}
+
+func stringFromMap(input map[string]interface{}, key string) string {
+	if v, ok := input[key]; ok && v != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return ""
+}
+
+func (e Error) Error() string {
+	lines := make([]string, 0)
+
+	summary := fmt.Sprintf("Code=%q Message=%q", e.Code, e.Message)
+	if e.Target != "" {
+		summary += fmt.Sprintf(" Target=%q", e.Target)
+	}
+	if e.Operation != "" {
+		summary = fmt.Sprintf("%s: %s", e.Operation, summary)
+	}
+	lines = append(lines, summary)
+
+	if len(e.Details) > 0 {
+		lines = append(lines, "Details:")
+		for _, detail := range e.Details {
+			line := fmt.Sprintf("  - Code=%q Message=%q", detail.Code, detail.Message)
+			if detail.Target != "" {
+				line += fmt.Sprintf(" Target=%q", detail.Target)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	if len(e.AdditionalInfo) > 0 {
+		lines = append(lines, "Additional Info:")
+		for _, info := range e.AdditionalInfo {
+			keys := make([]string, 0, len(info.Info))
+			for k := range info.Info {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			values := make([]string, 0, len(keys))
+			for _, k := range keys {
+				values = append(values, fmt.Sprintf("%s=%q", k, fmt.Sprintf("%v", info.Info[k])))
+			}
+
+			lines = append(lines, fmt.Sprintf("  - %s: %s", info.Type, strings.Join(values, " ")))
+		}
+	}
+
+	request := make([]string, 0)
+	if e.StatusCode != 0 {
+		request = append(request, fmt.Sprintf("Status Code: %d", e.StatusCode))
+	}
+	if e.RequestID != "" {
+		request = append(request, fmt.Sprintf("Request ID: %s", e.RequestID))
+	}
+	if e.CorrelationID != "" {
+		request = append(request, fmt.Sprintf("Correlation ID: %s", e.CorrelationID))
+	}
+	if len(request) > 0 {
+		lines = append(lines, strings.Join(request, ", "))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// IsAnotherOperationInProgressError returns whether the error was returned because another operation is in progress
+// on the same (Parent) Resource - for example when multiple Subnet Associations update the same Virtual Network -
+// in which case the operation can be retried once the other operation has completed
+func IsAnotherOperationInProgressError(err error) bool {
+	parsed := ParseError(err)
+	if parsed == nil {
+		return false
+	}
+
+	switch parsed.Code {
+	case "AnotherOperationInProgress", "RetryableError", "ReferencedResourceNotProvisioned":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,148 @@
+package azure
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestParseError(t *testing.T) {
+	target := "parameters.location"
+	header := http.Header{}
+	header.Set("x-ms-request-id", "11111111-1111-1111-1111-111111111111")
+	header.Set("x-ms-correlation-request-id", "22222222-2222-2222-2222-222222222222")
+
+	err := autorest.DetailedError{
+		PackageType: "resources.GroupsClient",
+		Method:      "CreateOrUpdate",
+		StatusCode:  http.StatusForbidden,
+		Response: &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     header,
+		},
+		Original: &azure.RequestError{
+			ServiceError: &azure.ServiceError{
+				Code:    "RequestDisallowedByPolicy",
+				Message: "Resource 'example' was disallowed by policy.",
+				Target:  &target,
+				Details: []map[string]interface{}{
+					{
+						"code":    "InvalidLocation",
+						"message": "The location isn't allowed.",
+					},
+				},
+				AdditionalInfo: []map[string]interface{}{
+					{
+						"type": "PolicyViolation",
+						"info": map[string]interface{}{
+							"policyAssignmentName":        "allowed-locations",
+							"policyDefinitionDisplayName": "Allowed locations",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	parsed := ParseError(err)
+	if parsed == nil {
+		t.Fatalf("Expected the error to be parsed but got nil")
+	}
+
+	if parsed.Operation != "resources.GroupsClient#CreateOrUpdate" {
+		t.Fatalf("Expected the Operation to be %q but got %q", "resources.GroupsClient#CreateOrUpdate", parsed.Operation)
+	}
+	if parsed.StatusCode != http.StatusForbidden {
+		t.Fatalf("Expected the Status Code to be %d but got %d", http.StatusForbidden, parsed.StatusCode)
+	}
+	if parsed.Code != "RequestDisallowedByPolicy" || parsed.Target != target {
+		t.Fatalf("Unexpected Code %q / Target %q", parsed.Code, parsed.Target)
+	}
+	if len(parsed.Details) != 1 || parsed.Details[0].Code != "InvalidLocation" {
+		t.Fatalf("Unexpected Details: %+v", parsed.Details)
+	}
+	if len(parsed.AdditionalInfo) != 1 || parsed.AdditionalInfo[0].Type != "PolicyViolation" {
+		t.Fatalf("Unexpected Additional Info: %+v", parsed.AdditionalInfo)
+	}
+	if parsed.RequestID != "11111111-1111-1111-1111-111111111111" || parsed.CorrelationID != "22222222-2222-2222-2222-222222222222" {
+		t.Fatalf("Unexpected Request ID %q / Correlation ID %q", parsed.RequestID, parsed.CorrelationID)
+	}
+
+	formatted := FormatError(err)
+	expected := []string{
+		`resources.GroupsClient#CreateOrUpdate: Code="RequestDisallowedByPolicy"`,
+		`  - Code="InvalidLocation" Message="The location isn't allowed."`,
+		`  - PolicyViolation: policyAssignmentName="allowed-locations" policyDefinitionDisplayName="Allowed locations"`,
+		`Correlation ID: 22222222-2222-2222-2222-222222222222`,
+	}
+	for _, v := range expected {
+		if !strings.Contains(formatted, v) {
+			t.Fatalf("Expected the formatted error to contain %q but got:\n%s", v, formatted)
+		}
+	}
+}
+
+func TestParseErrorLongRunningOperation(t *testing.T) {
+	err := autorest.DetailedError{
+		PackageType: "compute.VirtualMachinesCreateOrUpdateFuture",
+		Method:      "Result",
+		Original: &azure.ServiceError{
+			Code:    "OSProvisioningTimedOut",
+			Message: "OS Provisioning for VM 'example' did not finish in the allotted time.",
+		},
+	}
+
+	parsed := ParseError(err)
+	if parsed == nil {
+		t.Fatalf("Expected the error to be parsed but got nil")
+	}
+
+	if parsed.Code != "OSProvisioningTimedOut" {
+		t.Fatalf("Expected the Code to be %q but got %q", "OSProvisioningTimedOut", parsed.Code)
+	}
+}
+
+func TestFormatErrorNonAzureError(t *testing.T) {
+	if ParseError(errors.New("boom")) != nil {
+		t.Fatalf("Expected a non-Azure error not to be parsed")
+	}
+
+	if actual := FormatError(errors.New("boom")); actual != "boom" {
+		t.Fatalf("Expected %q but got %q", "boom", actual)
+	}
+
+	if actual := FormatError(nil); actual != "" {
+		t.Fatalf("Expected an empty string but got %q", actual)
+	}
+}
+
+func TestIsAnotherOperationInProgressError(t *testing.T) {
+	testData := map[string]bool{
+		"AnotherOperationInProgress":       true,
+		"RetryableError":                   true,
+		"ReferencedResourceNotProvisioned": true,
+		"InUseSubnetCannotBeDeleted":       false,
+		"Conflict":                         false,
+	}
+
+	for code, expected := range testData {
+		err := autorest.DetailedError{
+			StatusCode: http.StatusConflict,
+			Original: &azure.ServiceError{
+				Code: code,
+			},
+		}
+
+		if actual := IsAnotherOperationInProgressError(err); actual != expected {
+			t.Fatalf("Expected %q to be retryable: %t but got %t", code, expected, actual)
+		}
+	}
+
+	if IsAnotherOperationInProgressError(errors.New("AnotherOperationInProgress")) {
+		t.Fatalf("Expected a non-Azure error not to be retryable")
+	}
+}
@@ -0,0 +1,75 @@
+package azure
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// DefaultProgressInterval is the minimum interval between the progress of a long-running operation being logged
+const DefaultProgressInterval = 1 * time.Minute
+
+// WaitForCompletionWithProgress waits for a long-running operation to complete in the same way as `WaitForCompletionRef`
+// - however the status of the operation and the elapsed time are logged at INFO level (at most once per
+// DefaultProgressInterval) so that progress is visible when operations take a long time (e.g. Kubernetes Clusters)
+func WaitForCompletionWithProgress(ctx context.Context, future *azure.Future, client autorest.Client, description string) error {
+	return waitForCompletionWithProgress(ctx, future, client, description, DefaultProgressInterval)
+}
+
+func waitForCompletionWithProgress(ctx context.Context, future *azure.Future, client autorest.Client, description string, interval time.Duration) error {
+	start := time.Now()
+	lastLogged := start
+
+	cancelCtx := ctx
+	// if the provided context already has a deadline don't override it
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && client.PollingDuration != 0 {
+		var cancel context.CancelFunc
+		cancelCtx, cancel = context.WithTimeout(ctx, client.PollingDuration)
+		defer cancel()
+	}
+
+	log.Printf("[INFO] Waiting for %s..", description)
+
+	done, err := future.DoneWithContext(ctx, client)
+	for attempts := 0; !done; done, err = future.DoneWithContext(ctx, client) {
+		if attempts >= client.RetryAttempts {
+			return autorest.NewErrorWithError(err, "Future", "WaitForCompletion", future.Response(), "the number of retries has been exceeded")
+		}
+
+		if elapsed := time.Since(lastLogged); elapsed >= interval {
+			log.Printf("[INFO] Still waiting for %s (Status %q, elapsed %s)", description, future.Status(), time.Since(start).Round(time.Second))
+			lastLogged = time.Now()
+		}
+
+		// the delay is only backed off when polling fails, otherwise the Retry-After header (or the client's
+		// polling delay) is used
+		var delayAttempt int
+		var delay time.Duration
+		if err == nil {
+			var ok bool
+			delay, ok = future.GetPollingDelay()
+			if !ok {
+				delay = client.PollingDelay
+			}
+		} else {
+			log.Printf("[DEBUG] Error polling for the status of %s (attempt %d): %+v", description, attempts+1, err)
+			delayAttempt = attempts
+			delay = client.RetryDuration
+			attempts++
+		}
+
+		if !autorest.DelayForBackoff(delay, delayAttempt, cancelCtx.Done()) {
+			return autorest.NewErrorWithError(cancelCtx.Err(), "Future", "WaitForCompletion", future.Response(), "context has been cancelled")
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Finished waiting for %s (Status %q, elapsed %s)", description, future.Status(), time.Since(start).Round(time.Second))
+	return nil
+}
@@ -0,0 +1,110 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestWaitForCompletionWithProgress(t *testing.T) {
+	cases := []struct {
+		Name           string
+		FinalStatus    string
+		ExpectError    bool
+		ExpectedLogged []string
+	}{
+		{
+			Name:        "Succeeded",
+			FinalStatus: "Succeeded",
+			ExpectError: false,
+			ExpectedLogged: []string{
+				"[INFO] Waiting for Example Resource to be deleted..",
+				`[INFO] Still waiting for Example Resource to be deleted (Status "InProgress"`,
+				`[INFO] Finished waiting for Example Resource to be deleted (Status "Succeeded"`,
+			},
+		},
+		{
+			Name:        "Failed",
+			FinalStatus: "Failed",
+			ExpectError: true,
+			ExpectedLogged: []string{
+				`[INFO] Still waiting for Example Resource to be deleted (Status "InProgress"`,
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		var polls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if atomic.AddInt32(&polls, 1) < 3 {
+				fmt.Fprint(w, `{"status": "InProgress"}`)
+				return
+			}
+
+			fmt.Fprintf(w, `{"status": %q}`, v.FinalStatus)
+		}))
+
+		future := testFutureForDelete(t, server.URL)
+		client := autorest.Client{
+			PollingDelay:  time.Millisecond,
+			RetryAttempts: 3,
+			RetryDuration: time.Millisecond,
+		}
+
+		var buf bytes.Buffer
+		log.SetOutput(&buf)
+		err := waitForCompletionWithProgress(context.Background(), &future, client, "Example Resource to be deleted", 0)
+		log.SetOutput(os.Stderr)
+		server.Close()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		for _, expected := range v.ExpectedLogged {
+			if !strings.Contains(buf.String(), expected) {
+				t.Fatalf("Expected the log to contain %q but got:\n%s", expected, buf.String())
+			}
+		}
+	}
+}
+
+func testFutureForDelete(t *testing.T, endpoint string) azure.Future {
+	req, err := http.NewRequest(http.MethodDelete, endpoint+"/example", nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	resp := &http.Response{
+		StatusCode: http.StatusAccepted,
+		Header: http.Header{
+			"Azure-Asyncoperation": []string{endpoint + "/operations/1"},
+		},
+		Body:    http.NoBody,
+		Request: req,
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		t.Fatalf("Error building future: %+v", err)
+	}
+
+	return future
+}
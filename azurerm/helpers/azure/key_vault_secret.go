@@ -0,0 +1,33 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// GetKeyVaultSecretValue retrieves the value of the (versioned) Key Vault Secret at apply time, allowing
+// resources to reference a secret rather than the value being specified in the configuration
+func GetKeyVaultSecretValue(ctx context.Context, client keyvault.BaseClient, secretId string) (string, error) {
+	id, err := ParseKeyVaultChildID(secretId)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing Key Vault Secret ID %q: %+v", secretId, err)
+	}
+
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return "", fmt.Errorf("Error: Key Vault Secret %q (Version %q) was not found in Key Vault at URI %q", id.Name, id.Version, id.KeyVaultBaseUrl)
+		}
+
+		return "", fmt.Errorf("Error retrieving Key Vault Secret %q (Version %q / Key Vault URI %q): %+v", id.Name, id.Version, id.KeyVaultBaseUrl, err)
+	}
+
+	if resp.Value == nil {
+		return "", fmt.Errorf("Error: Key Vault Secret %q (Version %q / Key Vault URI %q) has no value", id.Name, id.Version, id.KeyVaultBaseUrl)
+	}
+
+	return *resp.Value, nil
+}
@@ -2,16 +2,43 @@ package azure
 
 import (
 	"log"
+	"net"
 	"net/http"
 	"net/http/httputil"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 )
 
+// DefaultMaxIdleConnsPerHost is the number of idle (keep-alive) connections kept open to each host when this isn't
+// configured. Go's default of 2 means most connections are closed (and re-established, including the TLS handshake)
+// when Terraform is making requests in parallel - since almost all requests are made to the same host.
+const DefaultMaxIdleConnsPerHost = 20
+
+// BuildSender returns a Sender using a new HTTP Transport with the default connection pool settings
 func BuildSender() autorest.Sender {
+	return BuildSenderWithMaxIdleConnsPerHost(DefaultMaxIdleConnsPerHost)
+}
+
+// BuildSenderWithMaxIdleConnsPerHost returns a Sender using a new HTTP Transport, which keeps up to the specified number
+// of idle connections open to each host. The Sender should be shared between API Clients so connections can be reused.
+func BuildSenderWithMaxIdleConnsPerHost(maxIdleConnsPerHost int) autorest.Sender {
+	if maxIdleConnsPerHost <= 0 {
+		maxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+
 	return autorest.DecorateSender(&http.Client{
 		Transport: &http.Transport{
 			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			MaxIdleConns:          maxIdleConnsPerHost * 4,
+			MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
 		},
 	}, withRequestLogging())
 }
@@ -41,6 +68,8 @@ func withRequestLogging() autorest.SendDecorator {
 
 			resp, err := s.Do(r)
 			if resp != nil {
+				log.Printf("[DEBUG] AzureRM Response IDs for %s %s: Status %q, Request ID %q, Correlation Request ID %q", r.Method, r.URL, resp.Status, resp.Header.Get("x-ms-request-id"), resp.Header.Get(HeaderCorrelationRequestID))
+
 				// dump response to wire format
 				if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
 					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
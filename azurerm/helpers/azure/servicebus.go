@@ -3,6 +3,7 @@ package azure
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"regexp"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -164,3 +165,18 @@ func ServiceBusAuthorizationRuleCustomizeDiff(d *schema.ResourceDiff, _ interfac
 
 	return nil
 }
+
+// ServiceBusFullyQualifiedNamespace returns the hostname of the ServiceBus Namespace (e.g. `example.servicebus.windows.net`)
+// from its endpoint, which is used by clients authenticating using Azure Active Directory rather than a Connection String
+func ServiceBusFullyQualifiedNamespace(endpoint string) (string, error) {
+	uri, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing %q as a URI: %+v", endpoint, err)
+	}
+
+	if uri.Hostname() == "" {
+		return "", fmt.Errorf("Error parsing the hostname from %q", endpoint)
+	}
+
+	return uri.Hostname(), nil
+}
@@ -0,0 +1,49 @@
+package azure
+
+import "testing"
+
+func TestServiceBusFullyQualifiedNamespace(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "example",
+			Error: true,
+		},
+		{
+			Input:    "https://example.servicebus.windows.net:443/",
+			Expected: "example.servicebus.windows.net",
+		},
+		{
+			Input:    "https://example.servicebus.chinacloudapi.cn/",
+			Expected: "example.servicebus.chinacloudapi.cn",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ServiceBusFullyQualifiedNamespace(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but got %q", actual)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package azure
+
+import "fmt"
+
+// SubnetID represents the ID of a Subnet - which is also the ID used for the Subnet Association resources
+type SubnetID struct {
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+func ParseSubnetID(input string) (*SubnetID, error) {
+	id, err := ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Subnet ID %q: %+v", input, err)
+	}
+
+	subnet := SubnetID{
+		ResourceGroup:      id.ResourceGroup,
+		VirtualNetworkName: id.Path["virtualNetworks"],
+		Name:               id.Path["subnets"],
+	}
+
+	if subnet.VirtualNetworkName == "" {
+		return nil, fmt.Errorf("ID was missing the `virtualNetworks` element")
+	}
+
+	if subnet.Name == "" {
+		return nil, fmt.Errorf("ID was missing the `subnets` element")
+	}
+
+	return &subnet, nil
+}
@@ -1,6 +1,9 @@
 package azure
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -40,3 +43,18 @@ func SchemaSubscription(subscriptionIDOptional bool) map[string]*schema.Schema {
 
 	return s
 }
+
+// ValidateResourceIDInSubscription returns an error when the Resource ID belongs to a Subscription other than
+// the one specified - which is used to catch references which can't be resolved by the configured Provider
+func ValidateResourceIDInSubscription(id string, subscriptionId string) error {
+	parsed, err := ParseAzureResourceID(id)
+	if err != nil {
+		return fmt.Errorf("Error parsing %q as a Resource ID: %+v", id, err)
+	}
+
+	if !strings.EqualFold(parsed.SubscriptionID, subscriptionId) {
+		return fmt.Errorf("Resource ID %q belongs to Subscription %q but the Provider is configured for Subscription %q - use a Provider alias configured for Subscription %q to manage resources referencing it", id, parsed.SubscriptionID, subscriptionId, parsed.SubscriptionID)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestValidateResourceIDInSubscription(t *testing.T) {
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+	cases := []struct {
+		Name        string
+		ID          string
+		ShouldError bool
+	}{
+		{
+			Name:        "empty",
+			ID:          "",
+			ShouldError: true,
+		},
+		{
+			Name:        "not a resource id",
+			ID:          "hello-world",
+			ShouldError: true,
+		},
+		{
+			Name:        "same subscription",
+			ID:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			ShouldError: false,
+		},
+		{
+			Name:        "similar subscription",
+			ID:          "/subscriptions/00000000-0000-0000-0000-00000000000A/resourceGroups/group1",
+			ShouldError: true,
+		},
+		{
+			Name:        "different subscription",
+			ID:          "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := ValidateResourceIDInSubscription(tc.ID, subscriptionId)
+			if tc.ShouldError && err == nil {
+				t.Fatalf("Expected an error for %q but didn't get one", tc.ID)
+			}
+
+			if !tc.ShouldError && err != nil {
+				t.Fatalf("Expected no error for %q but got: %+v", tc.ID, err)
+			}
+		})
+	}
+}
+
+func TestValidateResourceIDInSubscriptionIgnoresCase(t *testing.T) {
+	id := "/subscriptions/AAAAAAAA-0000-0000-0000-000000000000/resourceGroups/group1"
+	if err := ValidateResourceIDInSubscription(id, "aaaaaaaa-0000-0000-0000-000000000000"); err != nil {
+		t.Fatalf("Expected no error when the Subscription ID differs only by case but got: %+v", err)
+	}
+}
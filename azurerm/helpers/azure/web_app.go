@@ -0,0 +1,1142 @@
+package azure
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	webAppLinuxStackDocker = "DOCKER"
+	webAppLinuxStackDotNet = "DOTNETCORE"
+	webAppLinuxStackJava   = "JAVA"
+	webAppLinuxStackNode   = "NODE"
+	webAppLinuxStackPython = "PYTHON"
+	webAppLinuxStackTomcat = "TOMCAT"
+)
+
+func SchemaLinuxWebAppSiteConfig() *schema.Schema {
+	s := schemaWebAppSiteConfigCommon()
+
+	s["application_stack"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"docker_image": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"docker_image_tag": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"dotnet_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"2.1",
+						"2.2",
+					}, false),
+				},
+
+				"java_server": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						webAppLinuxStackJava,
+						webAppLinuxStackTomcat,
+					}, false),
+				},
+
+				"java_server_version": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"java_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"8",
+						"11",
+					}, false),
+				},
+
+				"node_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"8.11",
+						"10.14",
+						"12.9",
+					}, false),
+				},
+
+				"python_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"2.7",
+						"3.6",
+						"3.7",
+					}, false),
+				},
+			},
+		},
+	}
+
+	s["linux_fx_version"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func SchemaWindowsWebAppSiteConfig() *schema.Schema {
+	s := schemaWebAppSiteConfigCommon()
+
+	s["application_stack"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"docker_container_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"docker_container_tag": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"dotnet_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"v2.0",
+						"v4.0",
+					}, true),
+					DiffSuppressFunc: suppress.CaseDifference,
+				},
+
+				"java_container": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"JAVA",
+						"JETTY",
+						"TOMCAT",
+					}, true),
+					DiffSuppressFunc: suppress.CaseDifference,
+				},
+
+				"java_container_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"java_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"1.7",
+						"1.8",
+						"11",
+					}, false),
+				},
+
+				"node_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"8.11",
+						"10.14",
+						"12.9",
+					}, false),
+				},
+
+				"python_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"2.7",
+						"3.4",
+					}, false),
+				},
+			},
+		},
+	}
+
+	s["default_documents"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	s["managed_pipeline_mode"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(web.Classic),
+			string(web.Integrated),
+		}, true),
+		DiffSuppressFunc: suppress.CaseDifference,
+	}
+
+	s["remote_debugging_enabled"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	s["remote_debugging_version"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"VS2012",
+			"VS2013",
+			"VS2015",
+			"VS2017",
+		}, true),
+		DiffSuppressFunc: suppress.CaseDifference,
+	}
+
+	s["use_32_bit_worker_process"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+	}
+
+	s["windows_fx_version"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+// schemaWebAppSiteConfigCommon returns the `site_config` fields which are supported by both Linux and Windows Web Apps
+func schemaWebAppSiteConfigCommon() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"always_on": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"app_command_line": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"auto_heal_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"auto_heal_rules": schemaWebAppAutoHealRules(),
+
+		"ftps_state": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(web.AllAllowed),
+				string(web.Disabled),
+				string(web.FtpsOnly),
+			}, false),
+		},
+
+		"http2_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"ip_restriction": schemaAppServiceIPRestriction(),
+
+		"min_tls_version": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(web.OneFullStopZero),
+				string(web.OneFullStopOne),
+				string(web.OneFullStopTwo),
+			}, false),
+		},
+
+		"websockets_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func schemaWebAppAutoHealRules() *schema.Schema {
+	intervalSchema := &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validateWebAppAutoHealInterval,
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"action_type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(web.CustomAction),
+									string(web.LogEvent),
+									string(web.Recycle),
+								}, false),
+							},
+
+							"custom_action": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"executable": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+
+										"parameters": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+
+							"minimum_process_execution_time": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validateWebAppAutoHealInterval,
+							},
+						},
+					},
+				},
+
+				"trigger": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"private_memory_kb": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(102400),
+							},
+
+							"requests": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"count": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+
+										"interval": intervalSchema,
+									},
+								},
+							},
+
+							"slow_request": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"count": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+
+										"interval": intervalSchema,
+
+										"time_taken": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validateWebAppAutoHealInterval,
+										},
+									},
+								},
+							},
+
+							"status_code": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"count": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+
+										"interval": intervalSchema,
+
+										"status_code": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntBetween(101, 599),
+										},
+
+										"sub_status": {
+											Type:     schema.TypeInt,
+											Optional: true,
+										},
+
+										"win32_status": {
+											Type:     schema.TypeInt,
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateWebAppAutoHealInterval(i interface{}, k string) ([]string, []error) {
+	return validation.StringMatch(regexp.MustCompile(`^([0-9]+\.)?[0-9]{2}:[0-5][0-9]:[0-5][0-9]$`), fmt.Sprintf("%q must be a duration in the format `hh:mm:ss`", k))(i, k)
+}
+
+// LinuxWebAppCustomizeDiff validates the `application_stack` block within the `site_config` block at plan time
+func LinuxWebAppCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	stack := webAppApplicationStackFromDiff(d)
+	if stack == nil {
+		return nil
+	}
+
+	return validateLinuxWebAppApplicationStack(stack)
+}
+
+// WindowsWebAppCustomizeDiff validates the `application_stack` block within the `site_config` block at plan time
+func WindowsWebAppCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	stack := webAppApplicationStackFromDiff(d)
+	if stack == nil {
+		return nil
+	}
+
+	return validateWindowsWebAppApplicationStack(stack)
+}
+
+func webAppApplicationStackFromDiff(d *schema.ResourceDiff) map[string]interface{} {
+	stacks := d.Get("site_config.0.application_stack").([]interface{})
+	if len(stacks) == 0 || stacks[0] == nil {
+		return nil
+	}
+
+	stack := make(map[string]interface{})
+	for k, v := range stacks[0].(map[string]interface{}) {
+		stack[k] = v
+
+		// values which aren't known until apply (e.g. an image tag from another resource) count as being set
+		if !d.NewValueKnown(fmt.Sprintf("site_config.0.application_stack.0.%s", k)) {
+			stack[k] = "(known after apply)"
+		}
+	}
+
+	return stack
+}
+
+func validateLinuxWebAppApplicationStack(stack map[string]interface{}) error {
+	dockerImage := stack["docker_image"].(string)
+	dockerImageTag := stack["docker_image_tag"].(string)
+	javaServer := stack["java_server"].(string)
+	javaServerVersion := stack["java_server_version"].(string)
+	javaVersion := stack["java_version"].(string)
+
+	stacks := make([]string, 0)
+
+	if dockerImage != "" || dockerImageTag != "" {
+		if dockerImage == "" || dockerImageTag == "" {
+			return fmt.Errorf("`docker_image` and `docker_image_tag` must be specified together")
+		}
+		stacks = append(stacks, "docker")
+	}
+
+	if stack["dotnet_version"].(string) != "" {
+		stacks = append(stacks, "dotnet")
+	}
+
+	if javaServer != "" || javaServerVersion != "" || javaVersion != "" {
+		if javaServer == "" || javaServerVersion == "" || javaVersion == "" {
+			return fmt.Errorf("`java_server`, `java_server_version` and `java_version` must be specified together")
+		}
+		stacks = append(stacks, "java")
+	}
+
+	if stack["node_version"].(string) != "" {
+		stacks = append(stacks, "node")
+	}
+
+	if stack["python_version"].(string) != "" {
+		stacks = append(stacks, "python")
+	}
+
+	return validateWebAppApplicationStackCount(stacks)
+}
+
+func validateWindowsWebAppApplicationStack(stack map[string]interface{}) error {
+	containerName := stack["docker_container_name"].(string)
+	containerTag := stack["docker_container_tag"].(string)
+	javaContainer := stack["java_container"].(string)
+	javaContainerVersion := stack["java_container_version"].(string)
+	javaVersion := stack["java_version"].(string)
+
+	stacks := make([]string, 0)
+
+	if containerName != "" || containerTag != "" {
+		if containerName == "" || containerTag == "" {
+			return fmt.Errorf("`docker_container_name` and `docker_container_tag` must be specified together")
+		}
+		stacks = append(stacks, "docker")
+	}
+
+	if stack["dotnet_version"].(string) != "" {
+		stacks = append(stacks, "dotnet")
+	}
+
+	if javaContainer != "" || javaContainerVersion != "" || javaVersion != "" {
+		if (javaContainer == "") != (javaContainerVersion == "") {
+			return fmt.Errorf("`java_container` and `java_container_version` must be specified together")
+		}
+		if javaVersion == "" {
+			return fmt.Errorf("`java_version` must be specified with `java_container` and `java_container_version`")
+		}
+		stacks = append(stacks, "java")
+	}
+
+	if stack["node_version"].(string) != "" {
+		stacks = append(stacks, "node")
+	}
+
+	if stack["python_version"].(string) != "" {
+		stacks = append(stacks, "python")
+	}
+
+	return validateWebAppApplicationStackCount(stacks)
+}
+
+func validateWebAppApplicationStackCount(stacks []string) error {
+	if len(stacks) != 1 {
+		return fmt.Errorf("exactly one Application Stack must be specified within the `application_stack` block but got %d (%s)", len(stacks), strings.Join(stacks, ", "))
+	}
+
+	return nil
+}
+
+func ExpandLinuxWebAppSiteConfig(input []interface{}) *web.SiteConfig {
+	siteConfig := expandWebAppSiteConfigCommon(input)
+
+	if len(input) == 0 || input[0] == nil {
+		return siteConfig
+	}
+
+	config := input[0].(map[string]interface{})
+	siteConfig.LinuxFxVersion = utils.String(expandLinuxWebAppApplicationStack(config["application_stack"].([]interface{})))
+
+	return siteConfig
+}
+
+func ExpandWindowsWebAppSiteConfig(input []interface{}) *web.SiteConfig {
+	siteConfig := expandWebAppSiteConfigCommon(input)
+
+	if len(input) == 0 || input[0] == nil {
+		return siteConfig
+	}
+
+	config := input[0].(map[string]interface{})
+
+	expandWindowsWebAppApplicationStack(config["application_stack"].([]interface{}), siteConfig)
+
+	if v, ok := config["default_documents"]; ok {
+		siteConfig.DefaultDocuments = utils.ExpandStringArray(v.([]interface{}))
+	}
+
+	if v, ok := config["managed_pipeline_mode"]; ok {
+		siteConfig.ManagedPipelineMode = web.ManagedPipelineMode(v.(string))
+	}
+
+	if v, ok := config["remote_debugging_enabled"]; ok {
+		siteConfig.RemoteDebuggingEnabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := config["remote_debugging_version"]; ok {
+		siteConfig.RemoteDebuggingVersion = utils.String(v.(string))
+	}
+
+	if v, ok := config["use_32_bit_worker_process"]; ok {
+		siteConfig.Use32BitWorkerProcess = utils.Bool(v.(bool))
+	}
+
+	return siteConfig
+}
+
+func expandWebAppSiteConfigCommon(input []interface{}) *web.SiteConfig {
+	siteConfig := web.SiteConfig{}
+
+	if len(input) == 0 || input[0] == nil {
+		return &siteConfig
+	}
+
+	config := input[0].(map[string]interface{})
+
+	if v, ok := config["always_on"]; ok {
+		siteConfig.AlwaysOn = utils.Bool(v.(bool))
+	}
+
+	if v, ok := config["app_command_line"]; ok {
+		siteConfig.AppCommandLine = utils.String(v.(string))
+	}
+
+	if v, ok := config["auto_heal_enabled"]; ok {
+		siteConfig.AutoHealEnabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := config["auto_heal_rules"]; ok {
+		siteConfig.AutoHealRules = expandWebAppAutoHealRules(v.([]interface{}))
+	}
+
+	if v, ok := config["ftps_state"]; ok {
+		siteConfig.FtpsState = web.FtpsState(v.(string))
+	}
+
+	if v, ok := config["http2_enabled"]; ok {
+		siteConfig.HTTP20Enabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := config["ip_restriction"]; ok {
+		restrictions := expandAppServiceIPRestrictions(v.([]interface{}))
+		siteConfig.IPSecurityRestrictions = &restrictions
+	}
+
+	if v, ok := config["min_tls_version"]; ok {
+		siteConfig.MinTLSVersion = web.SupportedTLSVersions(v.(string))
+	}
+
+	if v, ok := config["websockets_enabled"]; ok {
+		siteConfig.WebSocketsEnabled = utils.Bool(v.(bool))
+	}
+
+	return &siteConfig
+}
+
+// expandLinuxWebAppApplicationStack returns the `linuxFxVersion` for the specified Application Stack,
+// which is in the format `STACK|version` - for example `NODE|10.14` or `DOCKER|nginx:latest`
+//
+// the Application Stack is validated in the CustomizeDiff, so exactly one stack is expected to be set
+func expandLinuxWebAppApplicationStack(input []interface{}) string {
+	if len(input) == 0 || input[0] == nil {
+		return ""
+	}
+
+	stack := input[0].(map[string]interface{})
+
+	if v := stack["docker_image"].(string); v != "" {
+		return fmt.Sprintf("%s|%s:%s", webAppLinuxStackDocker, v, stack["docker_image_tag"].(string))
+	}
+
+	if v := stack["dotnet_version"].(string); v != "" {
+		return fmt.Sprintf("%s|%s", webAppLinuxStackDotNet, v)
+	}
+
+	if v := stack["java_server"].(string); v != "" {
+		return fmt.Sprintf("%s|%s-%s", v, stack["java_server_version"].(string), linuxWebAppJavaRuntime(stack["java_version"].(string)))
+	}
+
+	if v := stack["node_version"].(string); v != "" {
+		return fmt.Sprintf("%s|%s", webAppLinuxStackNode, v)
+	}
+
+	if v := stack["python_version"].(string); v != "" {
+		return fmt.Sprintf("%s|%s", webAppLinuxStackPython, v)
+	}
+
+	return ""
+}
+
+// expandWindowsWebAppApplicationStack sets the fields for the specified Application Stack on the SiteConfig
+//
+// the Application Stack is validated in the CustomizeDiff, so exactly one stack is expected to be set
+func expandWindowsWebAppApplicationStack(input []interface{}, siteConfig *web.SiteConfig) {
+	if len(input) == 0 || input[0] == nil {
+		return
+	}
+
+	stack := input[0].(map[string]interface{})
+
+	if v := stack["docker_container_name"].(string); v != "" {
+		siteConfig.WindowsFxVersion = utils.String(fmt.Sprintf("%s|%s:%s", webAppLinuxStackDocker, v, stack["docker_container_tag"].(string)))
+	}
+
+	if v := stack["dotnet_version"].(string); v != "" {
+		siteConfig.NetFrameworkVersion = utils.String(v)
+	}
+
+	if v := stack["java_container"].(string); v != "" {
+		siteConfig.JavaContainer = utils.String(v)
+		siteConfig.JavaContainerVersion = utils.String(stack["java_container_version"].(string))
+	}
+
+	if v := stack["java_version"].(string); v != "" {
+		siteConfig.JavaVersion = utils.String(v)
+	}
+
+	if v := stack["node_version"].(string); v != "" {
+		siteConfig.NodeVersion = utils.String(v)
+	}
+
+	if v := stack["python_version"].(string); v != "" {
+		siteConfig.PythonVersion = utils.String(v)
+	}
+}
+
+func linuxWebAppJavaRuntime(javaVersion string) string {
+	if javaVersion == "8" {
+		return "jre8"
+	}
+
+	return fmt.Sprintf("java%s", javaVersion)
+}
+
+func expandWebAppAutoHealRules(input []interface{}) *web.AutoHealRules {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	rules := input[0].(map[string]interface{})
+	result := web.AutoHealRules{}
+
+	if actions := rules["action"].([]interface{}); len(actions) > 0 && actions[0] != nil {
+		action := actions[0].(map[string]interface{})
+		result.Actions = &web.AutoHealActions{
+			ActionType: web.AutoHealActionType(action["action_type"].(string)),
+		}
+
+		if v := action["minimum_process_execution_time"].(string); v != "" {
+			result.Actions.MinProcessExecutionTime = utils.String(v)
+		}
+
+		if customActions := action["custom_action"].([]interface{}); len(customActions) > 0 && customActions[0] != nil {
+			customAction := customActions[0].(map[string]interface{})
+			result.Actions.CustomAction = &web.AutoHealCustomAction{
+				Exe:        utils.String(customAction["executable"].(string)),
+				Parameters: utils.String(customAction["parameters"].(string)),
+			}
+		}
+	}
+
+	if triggers := rules["trigger"].([]interface{}); len(triggers) > 0 && triggers[0] != nil {
+		trigger := triggers[0].(map[string]interface{})
+		result.Triggers = &web.AutoHealTriggers{}
+
+		if v := trigger["private_memory_kb"].(int); v != 0 {
+			result.Triggers.PrivateBytesInKB = utils.Int32(int32(v))
+		}
+
+		if requests := trigger["requests"].([]interface{}); len(requests) > 0 && requests[0] != nil {
+			request := requests[0].(map[string]interface{})
+			result.Triggers.Requests = &web.RequestsBasedTrigger{
+				Count:        utils.Int32(int32(request["count"].(int))),
+				TimeInterval: utils.String(request["interval"].(string)),
+			}
+		}
+
+		if slowRequests := trigger["slow_request"].([]interface{}); len(slowRequests) > 0 && slowRequests[0] != nil {
+			slowRequest := slowRequests[0].(map[string]interface{})
+			result.Triggers.SlowRequests = &web.SlowRequestsBasedTrigger{
+				Count:        utils.Int32(int32(slowRequest["count"].(int))),
+				TimeInterval: utils.String(slowRequest["interval"].(string)),
+				TimeTaken:    utils.String(slowRequest["time_taken"].(string)),
+			}
+		}
+
+		statusCodes := make([]web.StatusCodesBasedTrigger, 0)
+		for _, raw := range trigger["status_code"].([]interface{}) {
+			statusCode := raw.(map[string]interface{})
+			statusCodes = append(statusCodes, web.StatusCodesBasedTrigger{
+				Count:        utils.Int32(int32(statusCode["count"].(int))),
+				TimeInterval: utils.String(statusCode["interval"].(string)),
+				Status:       utils.Int32(int32(statusCode["status_code"].(int))),
+				SubStatus:    utils.Int32(int32(statusCode["sub_status"].(int))),
+				Win32Status:  utils.Int32(int32(statusCode["win32_status"].(int))),
+			})
+		}
+		result.Triggers.StatusCodes = &statusCodes
+	}
+
+	return &result
+}
+
+func FlattenLinuxWebAppSiteConfig(input *web.SiteConfig) []interface{} {
+	if input == nil {
+		log.Printf("[DEBUG] SiteConfig is nil")
+		return []interface{}{}
+	}
+
+	result := flattenWebAppSiteConfigCommon(input)
+
+	linuxFxVersion := ""
+	if input.LinuxFxVersion != nil {
+		linuxFxVersion = *input.LinuxFxVersion
+	}
+	result["linux_fx_version"] = linuxFxVersion
+	result["application_stack"] = flattenLinuxWebAppApplicationStack(linuxFxVersion)
+
+	return []interface{}{result}
+}
+
+func FlattenWindowsWebAppSiteConfig(input *web.SiteConfig) []interface{} {
+	if input == nil {
+		log.Printf("[DEBUG] SiteConfig is nil")
+		return []interface{}{}
+	}
+
+	result := flattenWebAppSiteConfigCommon(input)
+
+	result["application_stack"] = flattenWindowsWebAppApplicationStack(input)
+	result["default_documents"] = utils.FlattenStringArray(input.DefaultDocuments)
+	result["managed_pipeline_mode"] = string(input.ManagedPipelineMode)
+
+	if input.RemoteDebuggingEnabled != nil {
+		result["remote_debugging_enabled"] = *input.RemoteDebuggingEnabled
+	}
+
+	if input.RemoteDebuggingVersion != nil {
+		result["remote_debugging_version"] = *input.RemoteDebuggingVersion
+	}
+
+	if input.Use32BitWorkerProcess != nil {
+		result["use_32_bit_worker_process"] = *input.Use32BitWorkerProcess
+	}
+
+	windowsFxVersion := ""
+	if input.WindowsFxVersion != nil {
+		windowsFxVersion = *input.WindowsFxVersion
+	}
+	result["windows_fx_version"] = windowsFxVersion
+
+	return []interface{}{result}
+}
+
+func flattenWebAppSiteConfigCommon(input *web.SiteConfig) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	if input.AlwaysOn != nil {
+		result["always_on"] = *input.AlwaysOn
+	}
+
+	if input.AppCommandLine != nil {
+		result["app_command_line"] = *input.AppCommandLine
+	}
+
+	if input.AutoHealEnabled != nil {
+		result["auto_heal_enabled"] = *input.AutoHealEnabled
+	}
+
+	result["auto_heal_rules"] = flattenWebAppAutoHealRules(input.AutoHealRules)
+	result["ftps_state"] = string(input.FtpsState)
+
+	if input.HTTP20Enabled != nil {
+		result["http2_enabled"] = *input.HTTP20Enabled
+	}
+
+	result["ip_restriction"] = flattenAppServiceIPRestrictions(input.IPSecurityRestrictions)
+	result["min_tls_version"] = string(input.MinTLSVersion)
+
+	if input.WebSocketsEnabled != nil {
+		result["websockets_enabled"] = *input.WebSocketsEnabled
+	}
+
+	return result
+}
+
+func flattenLinuxWebAppApplicationStack(linuxFxVersion string) []interface{} {
+	segments := strings.SplitN(linuxFxVersion, "|", 2)
+	if len(segments) != 2 {
+		return []interface{}{}
+	}
+
+	stack := map[string]interface{}{
+		"docker_image":        "",
+		"docker_image_tag":    "",
+		"dotnet_version":      "",
+		"java_server":         "",
+		"java_server_version": "",
+		"java_version":        "",
+		"node_version":        "",
+		"python_version":      "",
+	}
+
+	version := segments[1]
+	switch strings.ToUpper(segments[0]) {
+	case webAppLinuxStackDocker:
+		// the image can contain a registry with a port (e.g. `example.com:5000/image:tag`) so only split on a colon
+		// which appears after the last slash
+		image := version
+		tag := ""
+		if i := strings.LastIndex(version, ":"); i > strings.LastIndex(version, "/") {
+			image = version[:i]
+			tag = version[i+1:]
+		}
+		stack["docker_image"] = image
+		stack["docker_image_tag"] = tag
+
+	case webAppLinuxStackDotNet:
+		stack["dotnet_version"] = version
+
+	case webAppLinuxStackJava, webAppLinuxStackTomcat:
+		stack["java_server"] = strings.ToUpper(segments[0])
+		if i := strings.LastIndex(version, "-"); i != -1 {
+			stack["java_server_version"] = version[:i]
+			runtime := strings.ToLower(version[i+1:])
+			runtime = strings.TrimPrefix(runtime, "jre")
+			runtime = strings.TrimPrefix(runtime, "java")
+			stack["java_version"] = runtime
+		} else {
+			stack["java_server_version"] = version
+		}
+
+	case webAppLinuxStackNode:
+		stack["node_version"] = version
+
+	case webAppLinuxStackPython:
+		stack["python_version"] = version
+
+	default:
+		log.Printf("[DEBUG] Unsupported Application Stack %q - ignoring", linuxFxVersion)
+		return []interface{}{}
+	}
+
+	return []interface{}{stack}
+}
+
+// flattenWindowsWebAppApplicationStack returns the single Application Stack in use - since the API returns a
+// `netFrameworkVersion` for every Windows Web App, .NET is only used when no other Application Stack is set
+func flattenWindowsWebAppApplicationStack(input *web.SiteConfig) []interface{} {
+	stack := map[string]interface{}{
+		"docker_container_name":  "",
+		"docker_container_tag":   "",
+		"dotnet_version":         "",
+		"java_container":         "",
+		"java_container_version": "",
+		"java_version":           "",
+		"node_version":           "",
+		"python_version":         "",
+	}
+
+	if v := input.WindowsFxVersion; v != nil && *v != "" {
+		segments := strings.SplitN(*v, "|", 2)
+		if len(segments) == 2 && strings.EqualFold(segments[0], webAppLinuxStackDocker) {
+			image := segments[1]
+			if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+				stack["docker_container_name"] = image[:i]
+				stack["docker_container_tag"] = image[i+1:]
+			} else {
+				stack["docker_container_name"] = image
+			}
+			return []interface{}{stack}
+		}
+	}
+
+	if v := input.JavaVersion; v != nil && *v != "" {
+		stack["java_version"] = *v
+		if input.JavaContainer != nil {
+			stack["java_container"] = *input.JavaContainer
+		}
+		if input.JavaContainerVersion != nil {
+			stack["java_container_version"] = *input.JavaContainerVersion
+		}
+		return []interface{}{stack}
+	}
+
+	if v := input.PythonVersion; v != nil && *v != "" {
+		stack["python_version"] = *v
+		return []interface{}{stack}
+	}
+
+	if v := input.NodeVersion; v != nil && *v != "" {
+		stack["node_version"] = *v
+		return []interface{}{stack}
+	}
+
+	if v := input.NetFrameworkVersion; v != nil && *v != "" {
+		stack["dotnet_version"] = *v
+		return []interface{}{stack}
+	}
+
+	return []interface{}{}
+}
+
+func flattenWebAppAutoHealRules(input *web.AutoHealRules) []interface{} {
+	if input == nil || (input.Actions == nil && input.Triggers == nil) {
+		return []interface{}{}
+	}
+
+	actions := make([]interface{}, 0)
+	if action := input.Actions; action != nil {
+		customActions := make([]interface{}, 0)
+		if customAction := action.CustomAction; customAction != nil {
+			executable := ""
+			if customAction.Exe != nil {
+				executable = *customAction.Exe
+			}
+			parameters := ""
+			if customAction.Parameters != nil {
+				parameters = *customAction.Parameters
+			}
+			customActions = append(customActions, map[string]interface{}{
+				"executable": executable,
+				"parameters": parameters,
+			})
+		}
+
+		minimumProcessExecutionTime := ""
+		if action.MinProcessExecutionTime != nil {
+			minimumProcessExecutionTime = *action.MinProcessExecutionTime
+		}
+
+		actions = append(actions, map[string]interface{}{
+			"action_type":                    string(action.ActionType),
+			"custom_action":                  customActions,
+			"minimum_process_execution_time": minimumProcessExecutionTime,
+		})
+	}
+
+	triggers := make([]interface{}, 0)
+	if trigger := input.Triggers; trigger != nil {
+		privateMemoryKb := 0
+		if trigger.PrivateBytesInKB != nil {
+			privateMemoryKb = int(*trigger.PrivateBytesInKB)
+		}
+
+		requests := make([]interface{}, 0)
+		if v := trigger.Requests; v != nil {
+			requests = append(requests, map[string]interface{}{
+				"count":    flattenWebAppInt32(v.Count),
+				"interval": flattenWebAppString(v.TimeInterval),
+			})
+		}
+
+		slowRequests := make([]interface{}, 0)
+		if v := trigger.SlowRequests; v != nil {
+			slowRequests = append(slowRequests, map[string]interface{}{
+				"count":      flattenWebAppInt32(v.Count),
+				"interval":   flattenWebAppString(v.TimeInterval),
+				"time_taken": flattenWebAppString(v.TimeTaken),
+			})
+		}
+
+		statusCodes := make([]interface{}, 0)
+		if trigger.StatusCodes != nil {
+			for _, v := range *trigger.StatusCodes {
+				statusCodes = append(statusCodes, map[string]interface{}{
+					"count":        flattenWebAppInt32(v.Count),
+					"interval":     flattenWebAppString(v.TimeInterval),
+					"status_code":  flattenWebAppInt32(v.Status),
+					"sub_status":   flattenWebAppInt32(v.SubStatus),
+					"win32_status": flattenWebAppInt32(v.Win32Status),
+				})
+			}
+		}
+
+		triggers = append(triggers, map[string]interface{}{
+			"private_memory_kb": privateMemoryKb,
+			"requests":          requests,
+			"slow_request":      slowRequests,
+			"status_code":       statusCodes,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"action":  actions,
+			"trigger": triggers,
+		},
+	}
+}
+
+func flattenWebAppInt32(input *int32) int {
+	if input == nil {
+		return 0
+	}
+
+	return int(*input)
+}
+
+func flattenWebAppString(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	return *input
+}
@@ -0,0 +1,266 @@
+package azure
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandLinuxWebAppApplicationStack(t *testing.T) {
+	emptyStack := func() map[string]interface{} {
+		return map[string]interface{}{
+			"docker_image":        "",
+			"docker_image_tag":    "",
+			"dotnet_version":      "",
+			"java_server":         "",
+			"java_server_version": "",
+			"java_version":        "",
+			"node_version":        "",
+			"python_version":      "",
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]string
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:     "Docker",
+			Input:    map[string]string{"docker_image": "nginx", "docker_image_tag": "latest"},
+			Expected: "DOCKER|nginx:latest",
+		},
+		{
+			Name:  "Docker without a Tag",
+			Input: map[string]string{"docker_image": "nginx"},
+			Error: true,
+		},
+		{
+			Name:     ".NET Core",
+			Input:    map[string]string{"dotnet_version": "2.2"},
+			Expected: "DOTNETCORE|2.2",
+		},
+		{
+			Name:     "Java SE",
+			Input:    map[string]string{"java_server": "JAVA", "java_server_version": "8", "java_version": "8"},
+			Expected: "JAVA|8-jre8",
+		},
+		{
+			Name:     "Tomcat",
+			Input:    map[string]string{"java_server": "TOMCAT", "java_server_version": "9.0", "java_version": "11"},
+			Expected: "TOMCAT|9.0-java11",
+		},
+		{
+			Name:     "Node",
+			Input:    map[string]string{"node_version": "10.14"},
+			Expected: "NODE|10.14",
+		},
+		{
+			Name:     "Python",
+			Input:    map[string]string{"python_version": "3.7"},
+			Expected: "PYTHON|3.7",
+		},
+		{
+			Name:  "Java without a Server",
+			Input: map[string]string{"java_version": "11"},
+			Error: true,
+		},
+		{
+			Name:  "Multiple Stacks",
+			Input: map[string]string{"node_version": "10.14", "python_version": "3.7"},
+			Error: true,
+		},
+		{
+			Name:  "No Stacks",
+			Input: map[string]string{},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		stack := emptyStack()
+		for key, value := range v.Input {
+			stack[key] = value
+		}
+
+		if err := validateLinuxWebAppApplicationStack(stack); err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		actual := expandLinuxWebAppApplicationStack([]interface{}{stack})
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+
+		// the stack should round-trip, since this is how it's read back from the API
+		flattened := flattenLinuxWebAppApplicationStack(actual)
+		if !reflect.DeepEqual(flattened, []interface{}{stack}) {
+			t.Fatalf("Expected %+v to round-trip but got %+v", stack, flattened)
+		}
+	}
+}
+
+func TestWindowsWebAppApplicationStack(t *testing.T) {
+	emptyStack := func() map[string]interface{} {
+		return map[string]interface{}{
+			"docker_container_name":  "",
+			"docker_container_tag":   "",
+			"dotnet_version":         "",
+			"java_container":         "",
+			"java_container_version": "",
+			"java_version":           "",
+			"node_version":           "",
+			"python_version":         "",
+		}
+	}
+
+	testData := []struct {
+		Name  string
+		Input map[string]string
+		Error bool
+	}{
+		{
+			Name:  "Windows Container",
+			Input: map[string]string{"docker_container_name": "mcr.microsoft.com/windows/servercore", "docker_container_tag": "ltsc2019"},
+		},
+		{
+			Name:  "Windows Container without a Tag",
+			Input: map[string]string{"docker_container_name": "mcr.microsoft.com/windows/servercore"},
+			Error: true,
+		},
+		{
+			Name:  ".NET",
+			Input: map[string]string{"dotnet_version": "v4.0"},
+		},
+		{
+			Name:  "Java",
+			Input: map[string]string{"java_version": "1.8"},
+		},
+		{
+			Name:  "Java with a Container",
+			Input: map[string]string{"java_container": "TOMCAT", "java_container_version": "9.0", "java_version": "11"},
+		},
+		{
+			Name:  "Java Container without a Java Version",
+			Input: map[string]string{"java_container": "TOMCAT", "java_container_version": "9.0"},
+			Error: true,
+		},
+		{
+			Name:  "Java Container without a Container Version",
+			Input: map[string]string{"java_container": "TOMCAT", "java_version": "11"},
+			Error: true,
+		},
+		{
+			Name:  "Node",
+			Input: map[string]string{"node_version": "10.14"},
+		},
+		{
+			Name:  "Python",
+			Input: map[string]string{"python_version": "3.4"},
+		},
+		{
+			Name:  "Multiple Stacks",
+			Input: map[string]string{"dotnet_version": "v4.0", "python_version": "3.4"},
+			Error: true,
+		},
+		{
+			Name:  "No Stacks",
+			Input: map[string]string{},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		stack := emptyStack()
+		for key, value := range v.Input {
+			stack[key] = value
+		}
+
+		if err := validateWindowsWebAppApplicationStack(stack); err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		// the stack should round-trip, since this is how it's read back from the API
+		siteConfig := &web.SiteConfig{}
+		expandWindowsWebAppApplicationStack([]interface{}{stack}, siteConfig)
+		flattened := flattenWindowsWebAppApplicationStack(siteConfig)
+		if !reflect.DeepEqual(flattened, []interface{}{stack}) {
+			t.Fatalf("Expected %+v to round-trip but got %+v", stack, flattened)
+		}
+	}
+}
+
+func TestFlattenWindowsWebAppApplicationStackIgnoresDefaultDotNet(t *testing.T) {
+	siteConfig := &web.SiteConfig{
+		NetFrameworkVersion: utils.String("v4.0"),
+		NodeVersion:         utils.String("10.14"),
+	}
+
+	actual := flattenWindowsWebAppApplicationStack(siteConfig)
+	if len(actual) != 1 {
+		t.Fatalf("Expected 1 Application Stack but got %d", len(actual))
+	}
+
+	stack := actual[0].(map[string]interface{})
+	if stack["node_version"] != "10.14" || stack["dotnet_version"] != "" {
+		t.Fatalf("Expected only the Node Application Stack but got %+v", stack)
+	}
+}
+
+func TestFlattenLinuxWebAppApplicationStackDockerRegistryWithPort(t *testing.T) {
+	actual := flattenLinuxWebAppApplicationStack("DOCKER|example.azurecr.io:5000/hello-world:v1")
+	if len(actual) != 1 {
+		t.Fatalf("Expected 1 Application Stack but got %d", len(actual))
+	}
+
+	stack := actual[0].(map[string]interface{})
+	if stack["docker_image"] != "example.azurecr.io:5000/hello-world" || stack["docker_image_tag"] != "v1" {
+		t.Fatalf("Unexpected Image %q / Tag %q", stack["docker_image"], stack["docker_image_tag"])
+	}
+
+	if actual := flattenLinuxWebAppApplicationStack("COMPOSE|dmVyc2lvbg=="); len(actual) != 0 {
+		t.Fatalf("Expected an unsupported Application Stack to be ignored but got %+v", actual)
+	}
+}
+
+func TestValidateWebAppAutoHealInterval(t *testing.T) {
+	testData := map[string]bool{
+		"":            false,
+		"5m":          false,
+		"00:05:00":    true,
+		"01:00:00":    true,
+		"00:60:00":    false,
+		"1.00:00:00":  true,
+		"00:00:05.00": false,
+	}
+
+	for input, expected := range testData {
+		_, errors := validateWebAppAutoHealInterval(input, "interval")
+		if actual := len(errors) == 0; actual != expected {
+			t.Fatalf("Expected %q to be valid: %t but got %t", input, expected, actual)
+		}
+	}
+}
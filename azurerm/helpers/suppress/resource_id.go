@@ -0,0 +1,14 @@
+package suppress
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ResourceIDDifference suppresses differences between two Resource IDs which only differ by casing (Azure can return
+// segments such as `resourceGroups` and `providers` with different casing to the value which was sent) or a trailing
+// slash, since Resource IDs are case-insensitive
+func ResourceIDDifference(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(strings.TrimSuffix(old, "/"), strings.TrimSuffix(new, "/"))
+}
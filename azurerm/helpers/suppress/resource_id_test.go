@@ -0,0 +1,57 @@
+package suppress
+
+import "testing"
+
+func TestResourceIDDifference(t *testing.T) {
+	cases := []struct {
+		Name     string
+		IDA      string
+		IDB      string
+		Suppress bool
+	}{
+		{
+			Name:     "empty",
+			IDA:      "",
+			IDB:      "",
+			Suppress: true,
+		},
+		{
+			Name:     "empty vs id",
+			IDA:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			IDB:      "",
+			Suppress: false,
+		},
+		{
+			Name:     "same id",
+			IDA:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			IDB:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Suppress: true,
+		},
+		{
+			Name:     "different segment casing",
+			IDA:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			IDB:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/Group1/providers/microsoft.network/virtualNetworks/network1",
+			Suppress: true,
+		},
+		{
+			Name:     "trailing slash",
+			IDA:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/",
+			IDB:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Suppress: true,
+		},
+		{
+			Name:     "different id",
+			IDA:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			IDB:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network2",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if ResourceIDDifference("test", tc.IDA, tc.IDB, nil) != tc.Suppress {
+				t.Fatalf("Expected ResourceIDDifference to return %t for '%q' == '%q'", tc.Suppress, tc.IDA, tc.IDB)
+			}
+		})
+	}
+}
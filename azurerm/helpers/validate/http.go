@@ -0,0 +1,91 @@
+package validate
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// HTTPHeaders validates a map of HTTP Headers, ensuring each name is a valid RFC 7230 token
+// and each value doesn't contain any control characters which would split the header
+func HTTPHeaders(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be a map", k))
+		return
+	}
+
+	for name, raw := range v {
+		if _, errs := HTTPHeaderName(name, k); len(errs) > 0 {
+			errors = append(errors, errs...)
+			continue
+		}
+
+		value, ok := raw.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected the value of header %q in %q to be a string", name, k))
+			continue
+		}
+
+		if strings.ContainsAny(value, "\r\n\x00") {
+			// the value of an Authorization header is a credential, so it's intentionally not included in the error
+			if strings.EqualFold(name, "Authorization") {
+				errors = append(errors, fmt.Errorf("the value of header %q in %q must not contain control characters", name, k))
+			} else {
+				errors = append(errors, fmt.Errorf("the value of header %q in %q must not contain control characters: %q", name, k, value))
+			}
+		}
+	}
+
+	return
+}
+
+// HTTPHeaderName validates that the string is a valid HTTP Header name, which is defined as a `token` in RFC 7230
+func HTTPHeaderName(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q must not contain an empty header name", k))
+		return
+	}
+
+	for _, c := range v {
+		if !isHTTPTokenChar(c) {
+			errors = append(errors, fmt.Errorf("%q contains an invalid header name %q: header names can only contain letters, numbers and the characters \"!#$%%&'*+-.^_`|~\"", k, v))
+			return
+		}
+	}
+
+	return
+}
+
+func isHTTPTokenChar(c rune) bool {
+	if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' {
+		return true
+	}
+
+	return strings.ContainsRune("!#$%&'*+-.^_`|~", c)
+}
+
+// HTTPMethod validates that the string is one of the HTTP Methods supported by the Scheduler and Logic App
+// services, optionally ignoring the case (since the Scheduler service accepts values such as `Get`)
+func HTTPMethod(ignoreCase bool) schema.SchemaValidateFunc {
+	methods := []string{
+		http.MethodDelete,
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+		http.MethodPatch,
+		http.MethodPost,
+		http.MethodPut,
+	}
+
+	return validation.StringInSlice(methods, ignoreCase)
+}
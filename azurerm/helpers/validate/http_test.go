@@ -0,0 +1,175 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHTTPHeaderName(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Errors int
+	}{
+		{
+			Name:   "",
+			Errors: 1,
+		},
+		{
+			Name:   "Content-Type",
+			Errors: 0,
+		},
+		{
+			Name:   "x-ms-client-request-id",
+			Errors: 0,
+		},
+		{
+			Name:   "X_Custom.Header~1",
+			Errors: 0,
+		},
+		{
+			Name:   "Content Type",
+			Errors: 1,
+		},
+		{
+			Name:   "Content-Type:",
+			Errors: 1,
+		},
+		{
+			Name:   "Héader",
+			Errors: 1,
+		},
+		{
+			Name:   "(comment)",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := HTTPHeaderName(tc.Name, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected HTTPHeaderName to have %d not %d errors for %q", tc.Errors, len(errors), tc.Name)
+			}
+		})
+	}
+}
+
+func TestHTTPHeaders(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Headers map[string]interface{}
+		Errors  int
+	}{
+		{
+			Name:    "empty",
+			Headers: map[string]interface{}{},
+			Errors:  0,
+		},
+		{
+			Name: "valid",
+			Headers: map[string]interface{}{
+				"Content-Type":  "application/json",
+				"Authorization": "Bearer abc123",
+			},
+			Errors: 0,
+		},
+		{
+			Name: "invalid name",
+			Headers: map[string]interface{}{
+				"Content Type": "application/json",
+			},
+			Errors: 1,
+		},
+		{
+			Name: "value with newline",
+			Headers: map[string]interface{}{
+				"X-Custom": "hello\r\nX-Injected: true",
+			},
+			Errors: 1,
+		},
+		{
+			Name: "multiple invalid",
+			Headers: map[string]interface{}{
+				"Bad Header":    "value",
+				"Authorization": "Bearer\nabc",
+			},
+			Errors: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := HTTPHeaders(tc.Headers, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected HTTPHeaders to have %d not %d errors for %q", tc.Errors, len(errors), tc.Name)
+			}
+		})
+	}
+}
+
+func TestHTTPHeadersDoesNotExposeAuthorization(t *testing.T) {
+	headers := map[string]interface{}{
+		"Authorization": "Bearer super\nsecret",
+	}
+
+	_, errors := HTTPHeaders(headers, "test")
+	if len(errors) != 1 {
+		t.Fatalf("Expected HTTPHeaders to have 1 error but got %d", len(errors))
+	}
+
+	if msg := errors[0].Error(); strings.Contains(msg, "secret") {
+		t.Fatalf("Expected the error for an Authorization header not to contain its value but got %q", msg)
+	}
+}
+
+func TestHTTPMethod(t *testing.T) {
+	cases := []struct {
+		Method     string
+		IgnoreCase bool
+		Errors     int
+	}{
+		{
+			Method: "",
+			Errors: 1,
+		},
+		{
+			Method: "GET",
+			Errors: 0,
+		},
+		{
+			Method: "OPTIONS",
+			Errors: 0,
+		},
+		{
+			Method: "Head",
+			Errors: 1,
+		},
+		{
+			Method:     "Head",
+			IgnoreCase: true,
+			Errors:     0,
+		},
+		{
+			Method:     "Patch",
+			IgnoreCase: true,
+			Errors:     0,
+		},
+		{
+			Method:     "TRACE",
+			IgnoreCase: true,
+			Errors:     1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Method, func(t *testing.T) {
+			_, errors := HTTPMethod(tc.IgnoreCase)(tc.Method, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected HTTPMethod to have %d not %d errors for %q", tc.Errors, len(errors), tc.Method)
+			}
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package validate
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"regexp"
+	"strconv"
+	"strings"
 )
 
 func IPv6Address(i interface{}, k string) (warnings []string, errors []error) {
@@ -107,3 +110,152 @@ func validatePortNumber(i interface{}, k string, allowZero bool) (warnings []str
 
 	return warnings, errors
 }
+
+// CIDRNetworkAddress validates that the value is a CIDR block (e.g. `10.0.1.0/24`) where the address is the network
+// address - Azure rejects blocks such as `10.0.1.5/24` where host bits are set.
+func CIDRNetworkAddress(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	ip, ipNet, err := net.ParseCIDR(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid CIDR block (e.g. 10.0.0.0/16), got %q", k, v))
+		return
+	}
+
+	if !ip.Equal(ipNet.IP) {
+		errors = append(errors, fmt.Errorf("%q must be the network address of the CIDR block - got %q, did you mean %q?", k, v, ipNet.String()))
+	}
+
+	return warnings, errors
+}
+
+// IPv4AddressCIDROrRange validates that the value is either `*`, an IPv4 Address (`10.0.0.1`),
+// a CIDR block (`10.0.0.0/24`) or a range of IPv4 Addresses (`10.0.0.1-10.0.0.10`)
+func IPv4AddressCIDROrRange(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "*" {
+		return
+	}
+
+	if strings.Contains(v, "/") {
+		if _, _, err := net.ParseCIDR(v); err != nil {
+			errors = append(errors, fmt.Errorf("%q is not a valid CIDR block: %q", k, v))
+		}
+		return
+	}
+
+	if strings.Contains(v, "-") {
+		parts := strings.Split(v, "-")
+		start := net.ParseIP(strings.TrimSpace(parts[0])).To4()
+		end := net.ParseIP(strings.TrimSpace(parts[len(parts)-1])).To4()
+		if len(parts) != 2 || start == nil || end == nil {
+			errors = append(errors, fmt.Errorf("%q is not a valid range of IPv4 Addresses (e.g. 10.0.0.1-10.0.0.10): %q", k, v))
+			return
+		}
+
+		if bytes.Compare(start, end) > 0 {
+			errors = append(errors, fmt.Errorf("the start of the range %q must not be after the end of the range for %q", v, k))
+		}
+		return
+	}
+
+	if ip := net.ParseIP(v); ip.To4() == nil {
+		errors = append(errors, fmt.Errorf("%q must be `*`, an IPv4 Address, a CIDR block or a range of IPv4 Addresses - got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+// IPv4AddressCIDRRangeOrServiceTag validates that the value is either a value accepted by IPv4AddressCIDROrRange
+// or the name of a Service Tag, optionally scoped to a region (e.g. `AzureCloud` or `Storage.WestEurope`)
+func IPv4AddressCIDRRangeOrServiceTag(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*(\.[a-zA-Z0-9]+)?$`).MatchString(v) {
+		return
+	}
+
+	return IPv4AddressCIDROrRange(i, k)
+}
+
+// PortRangeOrWildcard validates that the value is either `*`, a single port (`80`) or a range of ports (`1024-2048`).
+// An empty value is accepted, since Optional port fields default to it when unset
+func PortRangeOrWildcard(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" || v == "*" {
+		return
+	}
+
+	parts := strings.Split(v, "-")
+	if len(parts) > 2 {
+		errors = append(errors, fmt.Errorf("%q must be `*`, a port (e.g. 80) or a range of ports (e.g. 1024-2048) - got %q", k, v))
+		return
+	}
+
+	ports := make([]int, 0)
+	for _, part := range parts {
+		port, err := strconv.Atoi(part)
+		if err != nil || port < 0 || port > 65535 {
+			errors = append(errors, fmt.Errorf("%q must be `*`, a port (e.g. 80) or a range of ports (e.g. 1024-2048) between 0 and 65535 - got %q", k, v))
+			return
+		}
+		ports = append(ports, port)
+	}
+
+	if len(ports) == 2 && ports[0] > ports[1] {
+		errors = append(errors, fmt.Errorf("the start of the port range %q must not be greater than the end of the port range for %q", v, k))
+	}
+
+	return warnings, errors
+}
+
+// CIDRIsWithinCIDR returns whether the CIDR block `inner` is entirely contained within the CIDR block `outer`
+func CIDRIsWithinCIDR(inner string, outer string) (bool, error) {
+	_, innerNet, err := net.ParseCIDR(inner)
+	if err != nil {
+		return false, fmt.Errorf("%q is not a valid CIDR block: %+v", inner, err)
+	}
+
+	_, outerNet, err := net.ParseCIDR(outer)
+	if err != nil {
+		return false, fmt.Errorf("%q is not a valid CIDR block: %+v", outer, err)
+	}
+
+	innerSize, _ := innerNet.Mask.Size()
+	outerSize, _ := outerNet.Mask.Size()
+	return outerNet.Contains(innerNet.IP) && innerSize >= outerSize, nil
+}
+
+// CIDRsOverlap returns whether the two CIDR blocks share any IP Addresses
+func CIDRsOverlap(first string, second string) (bool, error) {
+	_, firstNet, err := net.ParseCIDR(first)
+	if err != nil {
+		return false, fmt.Errorf("%q is not a valid CIDR block: %+v", first, err)
+	}
+
+	_, secondNet, err := net.ParseCIDR(second)
+	if err != nil {
+		return false, fmt.Errorf("%q is not a valid CIDR block: %+v", second, err)
+	}
+
+	// CIDR blocks are either disjoint or one contains the other, so checking the network addresses is sufficient
+	return firstNet.Contains(secondNet.IP) || secondNet.Contains(firstNet.IP), nil
+}
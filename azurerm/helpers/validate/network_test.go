@@ -331,3 +331,299 @@ func TestPortNumberOrZero(t *testing.T) {
 		})
 	}
 }
+
+func TestCIDRNetworkAddress(t *testing.T) {
+	cases := []struct {
+		CIDR   string
+		Errors int
+	}{
+		{
+			CIDR:   "",
+			Errors: 1,
+		},
+		{
+			CIDR:   "10.0.0.0",
+			Errors: 1,
+		},
+		{
+			CIDR:   "10.0.0.0/16",
+			Errors: 0,
+		},
+		{
+			CIDR:   "10.0.1.5/24",
+			Errors: 1,
+		},
+		{
+			CIDR:   "10.0.0.0/33",
+			Errors: 1,
+		},
+		{
+			CIDR:   "2001:db8::/32",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.CIDR, func(t *testing.T) {
+			_, errors := CIDRNetworkAddress(tc.CIDR, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected CIDRNetworkAddress to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
+func TestIPv4AddressCIDROrRange(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Errors int
+	}{
+		{
+			Input:  "",
+			Errors: 1,
+		},
+		{
+			Input:  "*",
+			Errors: 0,
+		},
+		{
+			Input:  "10.0.0.1",
+			Errors: 0,
+		},
+		{
+			Input:  "10.0.0.0/24",
+			Errors: 0,
+		},
+		{
+			Input:  "10.0.0.0/40",
+			Errors: 1,
+		},
+		{
+			Input:  "10.0.0.1-10.0.0.10",
+			Errors: 0,
+		},
+		{
+			Input:  "10.0.0.10-10.0.0.1",
+			Errors: 1,
+		},
+		{
+			Input:  "10.0.0.1-10.0.0.10-10.0.0.20",
+			Errors: 1,
+		},
+		{
+			Input:  "VirtualNetwork",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := IPv4AddressCIDROrRange(tc.Input, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected IPv4AddressCIDROrRange to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
+func TestIPv4AddressCIDRRangeOrServiceTag(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Errors int
+	}{
+		{
+			Input:  "",
+			Errors: 1,
+		},
+		{
+			Input:  "*",
+			Errors: 0,
+		},
+		{
+			Input:  "10.0.0.1",
+			Errors: 0,
+		},
+		{
+			Input:  "10.0.0.0/24",
+			Errors: 0,
+		},
+		{
+			Input:  "10.0.0.1-10.0.0.10",
+			Errors: 0,
+		},
+		{
+			Input:  "10.0.0.10-10.0.0.1",
+			Errors: 1,
+		},
+		{
+			Input:  "AzureCloud",
+			Errors: 0,
+		},
+		{
+			Input:  "Storage.WestEurope",
+			Errors: 0,
+		},
+		{
+			Input:  "AzureCloud.westeurope.extra",
+			Errors: 1,
+		},
+		{
+			Input:  "Azure Cloud",
+			Errors: 1,
+		},
+		{
+			Input:  "10.0.0.256",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := IPv4AddressCIDRRangeOrServiceTag(tc.Input, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected IPv4AddressCIDRRangeOrServiceTag to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
+func TestPortRangeOrWildcard(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Errors int
+	}{
+		{
+			Input:  "",
+			Errors: 0,
+		},
+		{
+			Input:  "*",
+			Errors: 0,
+		},
+		{
+			Input:  "80",
+			Errors: 0,
+		},
+		{
+			Input:  "65536",
+			Errors: 1,
+		},
+		{
+			Input:  "1024-2048",
+			Errors: 0,
+		},
+		{
+			Input:  "2048-1024",
+			Errors: 1,
+		},
+		{
+			Input:  "80-",
+			Errors: 1,
+		},
+		{
+			Input:  "1-2-3",
+			Errors: 1,
+		},
+		{
+			Input:  "http",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := PortRangeOrWildcard(tc.Input, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected PortRangeOrWildcard to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
+func TestCIDRIsWithinCIDR(t *testing.T) {
+	cases := []struct {
+		Inner    string
+		Outer    string
+		Expected bool
+	}{
+		{
+			Inner:    "10.0.1.0/24",
+			Outer:    "10.0.0.0/16",
+			Expected: true,
+		},
+		{
+			Inner:    "10.0.0.0/16",
+			Outer:    "10.0.0.0/16",
+			Expected: true,
+		},
+		{
+			Inner:    "10.0.0.0/8",
+			Outer:    "10.0.0.0/16",
+			Expected: false,
+		},
+		{
+			Inner:    "10.1.0.0/24",
+			Outer:    "10.0.0.0/16",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Inner+" within "+tc.Outer, func(t *testing.T) {
+			actual, err := CIDRIsWithinCIDR(tc.Inner, tc.Outer)
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if actual != tc.Expected {
+				t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestCIDRsOverlap(t *testing.T) {
+	cases := []struct {
+		First    string
+		Second   string
+		Expected bool
+	}{
+		{
+			First:    "10.0.0.0/16",
+			Second:   "10.1.0.0/16",
+			Expected: false,
+		},
+		{
+			First:    "10.0.0.0/16",
+			Second:   "10.0.2.0/24",
+			Expected: true,
+		},
+		{
+			First:    "10.0.2.0/24",
+			Second:   "10.0.0.0/16",
+			Expected: true,
+		},
+		{
+			First:    "10.0.1.0/24",
+			Second:   "10.0.2.0/24",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.First+" and "+tc.Second, func(t *testing.T) {
+			actual, err := CIDRsOverlap(tc.First, tc.Second)
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if actual != tc.Expected {
+				t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+			}
+		})
+	}
+}
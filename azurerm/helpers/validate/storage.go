@@ -0,0 +1,41 @@
+package validate
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+)
+
+// StorageMetaDataKeys validates the keys of a Storage MetaData map - which must be valid C# identifiers
+// and are returned lower-cased by the API, so upper-case characters would cause a perpetual diff
+func StorageMetaDataKeys(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be a map", k))
+		return
+	}
+
+	for key := range v {
+		if !regexp.MustCompile(`^[a-z_][a-z0-9_]*$`).MatchString(key) {
+			errors = append(errors, fmt.Errorf("%q contains the key %q which must be lower-case and can only contain letters, numbers and underscores and cannot start with a number", k, key))
+		}
+	}
+
+	return warnings, errors
+}
+
+// MD5Hash validates that the value is a hex-encoded MD5 hash, such as the value returned from Terraform's `md5` function
+func MD5Hash(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	decoded, err := hex.DecodeString(v)
+	if err != nil || len(decoded) != 16 {
+		errors = append(errors, fmt.Errorf("%q must be a hex-encoded MD5 hash but got %q", k, v))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package validate
+
+import "testing"
+
+func TestStorageMetaDataKeys(t *testing.T) {
+	cases := []struct {
+		Input  map[string]interface{}
+		Errors int
+	}{
+		{
+			Input:  map[string]interface{}{},
+			Errors: 0,
+		},
+		{
+			Input: map[string]interface{}{
+				"hello":        "world",
+				"_private":     "value",
+				"with_number1": "value",
+			},
+			Errors: 0,
+		},
+		{
+			Input: map[string]interface{}{
+				"Hello": "world",
+			},
+			Errors: 1,
+		},
+		{
+			Input: map[string]interface{}{
+				"1hello":      "world",
+				"hello-world": "value",
+			},
+			Errors: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := StorageMetaDataKeys(tc.Input, "metadata")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected %d errors for %+v but got %d: %+v", tc.Errors, tc.Input, len(errors), errors)
+		}
+	}
+}
+
+func TestMD5Hash(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "not-hex",
+			Errors: 1,
+		},
+		{
+			Value:  "d41d8cd98f00b204e9800998ecf8427e",
+			Errors: 0,
+		},
+		{
+			Value:  "D41D8CD98F00B204E9800998ECF8427E",
+			Errors: 0,
+		},
+		{
+			Value:  "d41d8cd98f00b204e9800998ecf842",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := MD5Hash(tc.Value, "content_md5")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected %d errors for %q but got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
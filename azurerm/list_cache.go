@@ -0,0 +1,69 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+// listCache caches the results of List operations for the lifetime of the Provider (e.g. a single plan or apply),
+// so that Data Sources which are used many times with the same arguments only make the underlying API calls once.
+//
+// This should only be used for data which isn't modified by Terraform (such as the available Resource SKUs or
+// Subscriptions) - since otherwise a Data Source could return a result from before a resource was created.
+type listCache struct {
+	lock    sync.Mutex
+	entries map[string]*listCacheEntry
+}
+
+type listCacheEntry struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+func newListCache() *listCache {
+	return &listCache{
+		entries: make(map[string]*listCacheEntry),
+	}
+}
+
+// get returns the cached value for the specified key, calling `load` to populate it if it's not present. Concurrent
+// callers for the same key wait for the first call to `load` to complete, rather than each making the API call.
+func (c *listCache) get(load func() (interface{}, error), keyParts ...string) (interface{}, error) {
+	key := listCacheKey(keyParts...)
+
+	c.lock.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &listCacheEntry{}
+		c.entries[key] = entry
+	}
+	c.lock.Unlock()
+
+	entry.once.Do(func() {
+		log.Printf("[DEBUG] List Cache miss for %q - loading..", key)
+		entry.value, entry.err = load()
+	})
+
+	if entry.err != nil {
+		// errors aren't cached, so that a subsequent call can retry
+		c.lock.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.lock.Unlock()
+	}
+
+	return entry.value, entry.err
+}
+
+func listCacheKey(keyParts ...string) string {
+	parts := make([]string, 0, len(keyParts))
+	for _, v := range keyParts {
+		parts = append(parts, fmt.Sprintf("%q", strings.ToLower(v)))
+	}
+
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,87 @@
+package azurerm
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestListCache_loadsOnce(t *testing.T) {
+	cache := newListCache()
+	var calls int32
+
+	load := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return []string{"first", "second"}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := cache.get(load, "resourceSkus", "00000000-0000-0000-0000-000000000000")
+			if err != nil {
+				t.Errorf("Expected no error but got: %+v", err)
+				return
+			}
+			if len(v.([]string)) != 2 {
+				t.Errorf("Expected 2 values but got %d", len(v.([]string)))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("Expected the load function to be called once but it was called %d times", calls)
+	}
+
+	// keys are case-insensitive, whereas different keys are loaded separately
+	if _, err := cache.get(load, "ResourceSkus", "00000000-0000-0000-0000-000000000000"); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if _, err := cache.get(load, "resourceSkus", "11111111-1111-1111-1111-111111111111"); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("Expected the load function to be called twice but it was called %d times", calls)
+	}
+}
+
+func TestListCache_errorsAreNotCached(t *testing.T) {
+	cache := newListCache()
+	calls := 0
+
+	load := func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, fmt.Errorf("throttled")
+		}
+		return "value", nil
+	}
+
+	if _, err := cache.get(load, "subscriptions"); err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	v, err := cache.get(load, "subscriptions")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if v.(string) != "value" {
+		t.Fatalf("Expected %q but got %q", "value", v.(string))
+	}
+
+	if calls != 2 {
+		t.Fatalf("Expected the load function to be called twice but it was called %d times", calls)
+	}
+}
+
+func TestListCacheKey(t *testing.T) {
+	// the parts are quoted, so that values containing the separator can't collide
+	if listCacheKey("a/b", "c") == listCacheKey("a", "b/c") {
+		t.Fatalf("Expected the keys to differ")
+	}
+}
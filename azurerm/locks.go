@@ -1,5 +1,14 @@
 package azurerm
 
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
 // handle the case of using the same name for different kinds of resources
 func azureRMLockByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
@@ -22,3 +31,79 @@ func azureRMUnlockMultipleByName(names *[]string, resourceType string) {
 		azureRMUnlockByName(name, resourceType)
 	}
 }
+
+// azureRMLockResourceTypes maps the (lower-cased) Resource Types found in a Resource ID to the Resource Type used
+// by azureRMLockByName - so that locking on a Resource ID also locks against the Resource itself
+var azureRMLockResourceTypes = map[string]string{
+	"networkinterfaces":     networkInterfaceResourceName,
+	"networksecuritygroups": networkSecurityGroupResourceName,
+	"routetables":           routeTableResourceName,
+	"subnets":               subnetResourceName,
+	"virtualnetworks":       virtualNetworkResourceName,
+}
+
+// azureRMLockByID locks on the Resource and each of its Parent Resources (e.g. both the Virtual Network and the
+// Subnet for a Subnet ID), outermost first. This is used by resources which update a collection shared on a
+// Parent Resource (e.g. Subnet Associations), to ensure these changes are serialised both with each other
+// and with the Parent Resource itself
+func azureRMLockByID(id string) {
+	for _, key := range azureRMLockKeysForID(id) {
+		armMutexKV.Lock(key)
+	}
+}
+
+func azureRMUnlockByID(id string) {
+	keys := azureRMLockKeysForID(id)
+	for i := len(keys) - 1; i >= 0; i-- {
+		armMutexKV.Unlock(keys[i])
+	}
+}
+
+func azureRMLockKeysForID(id string) []string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+
+	providersIndex := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") {
+			providersIndex = i
+		}
+	}
+
+	// Resource ID's are case-insensitive, so the ID is used as-is when it's not one we can parse
+	if providersIndex == -1 || len(segments) < providersIndex+4 {
+		return []string{strings.ToLower(id)}
+	}
+
+	keys := make([]string, 0)
+	for i := providersIndex + 2; i+1 < len(segments); i += 2 {
+		resourceType := segments[i]
+		name := segments[i+1]
+
+		if lockType, ok := azureRMLockResourceTypes[strings.ToLower(resourceType)]; ok {
+			keys = append(keys, lockType+"."+name)
+			continue
+		}
+
+		keys = append(keys, strings.ToLower("/"+strings.Join(segments[:i+2], "/")))
+	}
+
+	return keys
+}
+
+// azureRMRetryWhileParentResourceIsBusy retries the specified function whilst Azure returns that another operation
+// is in progress on the Parent Resource, which happens when multiple Child Resources update the same Parent Resource
+// concurrently - including changes made outside of Terraform, which aren't covered by azureRMLockByID
+func azureRMRetryWhileParentResourceIsBusy(f func() error) error {
+	return resource.Retry(60*time.Minute, func() *resource.RetryError {
+		if err := f(); err != nil {
+			if azure.IsAnotherOperationInProgressError(err) {
+				log.Printf("[DEBUG] Another operation is in progress on the Parent Resource - retrying: %+v", err)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
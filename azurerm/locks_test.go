@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAzureRMLockKeysForID(t *testing.T) {
+	cases := []struct {
+		ID       string
+		Expected []string
+	}{
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: []string{"azurerm_virtual_network.network1", "azurerm_subnet.subnet1"},
+		},
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/routeTables/table1",
+			Expected: []string{"azurerm_route_table.table1"},
+		},
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1",
+			Expected: []string{"azurerm_network_interface.nic1"},
+		},
+		{
+			ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/gateway1/backendAddressPools/pool1",
+			Expected: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/applicationgateways/gateway1",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/applicationgateways/gateway1/backendaddresspools/pool1",
+			},
+		},
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Group1",
+			Expected: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1"},
+		},
+	}
+
+	for _, v := range cases {
+		actual := azureRMLockKeysForID(v.ID)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected the Lock Keys for %q to be %+v but got %+v", v.ID, v.Expected, actual)
+		}
+	}
+}
@@ -5,12 +5,16 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
@@ -95,13 +99,44 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"hash_sensitive_values": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_HASH_SENSITIVE_VALUES", false),
+			},
+
+			"eventual_consistency_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_EVENTUAL_CONSISTENCY_TIMEOUT_IN_MINUTES", 5),
+				ValidateFunc: validation.IntBetween(0, 60),
+			},
+
+			"max_idle_connections_per_host": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_IDLE_CONNECTIONS_PER_HOST", azure.DefaultMaxIdleConnsPerHost),
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+
+			"correlation_request_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_CORRELATION_REQUEST_ID", ""),
+				ValidateFunc: validate.UUIDOrEmpty,
+			},
+
+			"features": schemaFeatures(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"azurerm_access_token":                           dataSourceArmAccessToken(),
 			"azurerm_api_management":                         dataSourceApiManagementService(),
 			"azurerm_api_management_api":                     dataSourceApiManagementApi(),
 			"azurerm_api_management_group":                   dataSourceApiManagementGroup(),
 			"azurerm_api_management_product":                 dataSourceApiManagementProduct(),
+			"azurerm_api_management_subscription":            dataSourceArmApiManagementSubscription(),
 			"azurerm_api_management_user":                    dataSourceArmApiManagementUser(),
 			"azurerm_app_service_plan":                       dataSourceAppServicePlan(),
 			"azurerm_app_service":                            dataSourceArmAppService(),
@@ -120,15 +155,19 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_data_lake_store":                        dataSourceArmDataLakeStoreAccount(),
 			"azurerm_dev_test_lab":                           dataSourceArmDevTestLab(),
 			"azurerm_dns_zone":                               dataSourceArmDnsZone(),
+			"azurerm_eventhub_authorization_rule":            dataSourceArmEventHubAuthorizationRule(),
 			"azurerm_eventhub_namespace":                     dataSourceEventHubNamespace(),
+			"azurerm_eventhub_namespace_authorization_rule":  dataSourceArmEventHubNamespaceAuthorizationRule(),
 			"azurerm_image":                                  dataSourceArmImage(),
 			"azurerm_key_vault_access_policy":                dataSourceArmKeyVaultAccessPolicy(),
 			"azurerm_key_vault_key":                          dataSourceArmKeyVaultKey(),
 			"azurerm_key_vault_secret":                       dataSourceArmKeyVaultSecret(),
 			"azurerm_key_vault":                              dataSourceArmKeyVault(),
 			"azurerm_kubernetes_cluster":                     dataSourceArmKubernetesCluster(),
+			"azurerm_kubernetes_service_versions":            dataSourceArmKubernetesServiceVersions(),
 			"azurerm_lb":                                     dataSourceArmLoadBalancer(),
 			"azurerm_lb_backend_address_pool":                dataSourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_location":                               dataSourceArmLocation(),
 			"azurerm_log_analytics_workspace":                dataSourceLogAnalyticsWorkspace(),
 			"azurerm_logic_app_workflow":                     dataSourceArmLogicAppWorkflow(),
 			"azurerm_managed_disk":                           dataSourceArmManagedDisk(),
@@ -145,163 +184,184 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_policy_definition":                      dataSourceArmPolicyDefinition(),
 			"azurerm_public_ip":                              dataSourceArmPublicIP(),
 			"azurerm_public_ips":                             dataSourceArmPublicIPs(),
+			"azurerm_quota":                                  dataSourceArmQuota(),
 			"azurerm_recovery_services_vault":                dataSourceArmRecoveryServicesVault(),
+			"azurerm_recovery_services_protectable_items":    dataSourceArmRecoveryServicesProtectableItems(),
 			"azurerm_recovery_services_protection_policy_vm": dataSourceArmRecoveryServicesProtectionPolicyVm(),
 			"azurerm_resource_group":                         dataSourceArmResourceGroup(),
 			"azurerm_role_definition":                        dataSourceArmRoleDefinition(),
 			"azurerm_route_table":                            dataSourceArmRouteTable(),
 			"azurerm_scheduler_job_collection":               dataSourceArmSchedulerJobCollection(),
+			"azurerm_scheduler_job_migration":                dataSourceArmSchedulerJobMigration(),
 			"azurerm_servicebus_namespace":                   dataSourceArmServiceBusNamespace(),
+			"azurerm_servicebus_queue_authorization_rule":    dataSourceArmServiceBusQueueAuthorizationRule(),
+			"azurerm_servicebus_topic_authorization_rule":    dataSourceArmServiceBusTopicAuthorizationRule(),
 			"azurerm_shared_image_gallery":                   dataSourceArmSharedImageGallery(),
 			"azurerm_shared_image_version":                   dataSourceArmSharedImageVersion(),
 			"azurerm_shared_image":                           dataSourceArmSharedImage(),
 			"azurerm_snapshot":                               dataSourceArmSnapshot(),
 			"azurerm_storage_account_sas":                    dataSourceArmStorageAccountSharedAccessSignature(),
 			"azurerm_storage_account":                        dataSourceArmStorageAccount(),
+			"azurerm_storage_blobs":                          dataSourceArmStorageBlobs(),
+			"azurerm_storage_containers":                     dataSourceArmStorageContainers(),
 			"azurerm_subnet":                                 dataSourceArmSubnet(),
 			"azurerm_subscription":                           dataSourceArmSubscription(),
 			"azurerm_subscriptions":                          dataSourceArmSubscriptions(),
 			"azurerm_traffic_manager_geographical_location":  dataSourceArmTrafficManagerGeographicalLocation(),
 			"azurerm_virtual_machine":                        dataSourceArmVirtualMachine(),
+			"azurerm_virtual_machine_sizes":                  dataSourceArmVirtualMachineSizes(),
 			"azurerm_virtual_network_gateway":                dataSourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network":                        dataSourceArmVirtualNetwork(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"azurerm_api_management":                         resourceArmApiManagementService(),
-			"azurerm_api_management_api":                     resourceArmApiManagementApi(),
-			"azurerm_api_management_api_operation":           resourceArmApiManagementApiOperation(),
-			"azurerm_api_management_group":                   resourceArmApiManagementGroup(),
-			"azurerm_api_management_group_user":              resourceArmApiManagementGroupUser(),
-			"azurerm_api_management_logger":                  resourceArmApiManagementLogger(),
-			"azurerm_api_management_product":                 resourceArmApiManagementProduct(),
-			"azurerm_api_management_product_api":             resourceArmApiManagementProductApi(),
-			"azurerm_api_management_product_group":           resourceArmApiManagementProductGroup(),
-			"azurerm_api_management_property":                resourceArmApiManagementProperty(),
-			"azurerm_api_management_subscription":            resourceArmApiManagementSubscription(),
-			"azurerm_api_management_user":                    resourceArmApiManagementUser(),
-			"azurerm_app_service_active_slot":                resourceArmAppServiceActiveSlot(),
-			"azurerm_app_service_custom_hostname_binding":    resourceArmAppServiceCustomHostnameBinding(),
-			"azurerm_app_service_plan":                       resourceArmAppServicePlan(),
-			"azurerm_app_service_slot":                       resourceArmAppServiceSlot(),
-			"azurerm_app_service":                            resourceArmAppService(),
-			"azurerm_application_gateway":                    resourceArmApplicationGateway(),
-			"azurerm_application_insights_api_key":           resourceArmApplicationInsightsAPIKey(),
-			"azurerm_application_insights":                   resourceArmApplicationInsights(),
-			"azurerm_application_security_group":             resourceArmApplicationSecurityGroup(),
-			"azurerm_automation_account":                     resourceArmAutomationAccount(),
-			"azurerm_automation_credential":                  resourceArmAutomationCredential(),
-			"azurerm_automation_dsc_configuration":           resourceArmAutomationDscConfiguration(),
-			"azurerm_automation_dsc_nodeconfiguration":       resourceArmAutomationDscNodeConfiguration(),
-			"azurerm_automation_module":                      resourceArmAutomationModule(),
-			"azurerm_automation_runbook":                     resourceArmAutomationRunbook(),
-			"azurerm_automation_schedule":                    resourceArmAutomationSchedule(),
-			"azurerm_autoscale_setting":                      resourceArmAutoScaleSetting(),
-			"azurerm_availability_set":                       resourceArmAvailabilitySet(),
-			"azurerm_azuread_application":                    resourceArmActiveDirectoryApplication(),
-			"azurerm_azuread_service_principal_password":     resourceArmActiveDirectoryServicePrincipalPassword(),
-			"azurerm_azuread_service_principal":              resourceArmActiveDirectoryServicePrincipal(),
-			"azurerm_batch_account":                          resourceArmBatchAccount(),
-			"azurerm_batch_pool":                             resourceArmBatchPool(),
-			"azurerm_cdn_endpoint":                           resourceArmCdnEndpoint(),
-			"azurerm_cdn_profile":                            resourceArmCdnProfile(),
-			"azurerm_cognitive_account":                      resourceArmCognitiveAccount(),
-			"azurerm_connection_monitor":                     resourceArmConnectionMonitor(),
-			"azurerm_container_group":                        resourceArmContainerGroup(),
-			"azurerm_container_registry":                     resourceArmContainerRegistry(),
-			"azurerm_container_service":                      resourceArmContainerService(),
-			"azurerm_cosmosdb_account":                       resourceArmCosmosDBAccount(),
-			"azurerm_data_lake_analytics_account":            resourceArmDataLakeAnalyticsAccount(),
-			"azurerm_data_lake_analytics_firewall_rule":      resourceArmDataLakeAnalyticsFirewallRule(),
-			"azurerm_data_lake_store_file":                   resourceArmDataLakeStoreFile(),
-			"azurerm_data_lake_store_firewall_rule":          resourceArmDataLakeStoreFirewallRule(),
-			"azurerm_data_lake_store":                        resourceArmDataLakeStore(),
-			"azurerm_databricks_workspace":                   resourceArmDatabricksWorkspace(),
-			"azurerm_ddos_protection_plan":                   resourceArmDDoSProtectionPlan(),
-			"azurerm_dev_test_lab":                           resourceArmDevTestLab(),
-			"azurerm_dev_test_linux_virtual_machine":         resourceArmDevTestLinuxVirtualMachine(),
-			"azurerm_dev_test_policy":                        resourceArmDevTestPolicy(),
-			"azurerm_dev_test_virtual_network":               resourceArmDevTestVirtualNetwork(),
-			"azurerm_dev_test_windows_virtual_machine":       resourceArmDevTestWindowsVirtualMachine(),
-			"azurerm_devspace_controller":                    resourceArmDevSpaceController(),
-			"azurerm_dns_a_record":                           resourceArmDnsARecord(),
-			"azurerm_dns_aaaa_record":                        resourceArmDnsAAAARecord(),
-			"azurerm_dns_caa_record":                         resourceArmDnsCaaRecord(),
-			"azurerm_dns_cname_record":                       resourceArmDnsCNameRecord(),
-			"azurerm_dns_mx_record":                          resourceArmDnsMxRecord(),
-			"azurerm_dns_ns_record":                          resourceArmDnsNsRecord(),
-			"azurerm_dns_ptr_record":                         resourceArmDnsPtrRecord(),
-			"azurerm_dns_srv_record":                         resourceArmDnsSrvRecord(),
-			"azurerm_dns_txt_record":                         resourceArmDnsTxtRecord(),
-			"azurerm_dns_zone":                               resourceArmDnsZone(),
-			"azurerm_eventgrid_domain":                       resourceArmEventGridDomain(),
-			"azurerm_eventgrid_event_subscription":           resourceArmEventGridEventSubscription(),
-			"azurerm_eventgrid_topic":                        resourceArmEventGridTopic(),
-			"azurerm_eventhub_authorization_rule":            resourceArmEventHubAuthorizationRule(),
-			"azurerm_eventhub_consumer_group":                resourceArmEventHubConsumerGroup(),
-			"azurerm_eventhub_namespace_authorization_rule":  resourceArmEventHubNamespaceAuthorizationRule(),
-			"azurerm_eventhub_namespace":                     resourceArmEventHubNamespace(),
-			"azurerm_eventhub":                               resourceArmEventHub(),
-			"azurerm_express_route_circuit_authorization":    resourceArmExpressRouteCircuitAuthorization(),
-			"azurerm_express_route_circuit_peering":          resourceArmExpressRouteCircuitPeering(),
-			"azurerm_express_route_circuit":                  resourceArmExpressRouteCircuit(),
-			"azurerm_firewall_application_rule_collection":   resourceArmFirewallApplicationRuleCollection(),
-			"azurerm_firewall_network_rule_collection":       resourceArmFirewallNetworkRuleCollection(),
-			"azurerm_firewall":                               resourceArmFirewall(),
-			"azurerm_function_app":                           resourceArmFunctionApp(),
-			"azurerm_image":                                  resourceArmImage(),
-			"azurerm_iothub_consumer_group":                  resourceArmIotHubConsumerGroup(),
-			"azurerm_iothub":                                 resourceArmIotHub(),
-			"azurerm_key_vault_access_policy":                resourceArmKeyVaultAccessPolicy(),
-			"azurerm_key_vault_certificate":                  resourceArmKeyVaultCertificate(),
-			"azurerm_key_vault_key":                          resourceArmKeyVaultKey(),
-			"azurerm_key_vault_secret":                       resourceArmKeyVaultSecret(),
-			"azurerm_key_vault":                              resourceArmKeyVault(),
-			"azurerm_kubernetes_cluster":                     resourceArmKubernetesCluster(),
-			"azurerm_lb_backend_address_pool":                resourceArmLoadBalancerBackendAddressPool(),
-			"azurerm_lb_nat_pool":                            resourceArmLoadBalancerNatPool(),
-			"azurerm_lb_nat_rule":                            resourceArmLoadBalancerNatRule(),
-			"azurerm_lb_probe":                               resourceArmLoadBalancerProbe(),
-			"azurerm_lb_outbound_rule":                       resourceArmLoadBalancerOutboundRule(),
-			"azurerm_lb_rule":                                resourceArmLoadBalancerRule(),
-			"azurerm_lb":                                     resourceArmLoadBalancer(),
-			"azurerm_local_network_gateway":                  resourceArmLocalNetworkGateway(),
-			"azurerm_log_analytics_solution":                 resourceArmLogAnalyticsSolution(),
-			"azurerm_log_analytics_linked_service":           resourceArmLogAnalyticsLinkedService(),
-			"azurerm_log_analytics_workspace_linked_service": resourceArmLogAnalyticsWorkspaceLinkedService(),
-			"azurerm_log_analytics_workspace":                resourceArmLogAnalyticsWorkspace(),
-			"azurerm_logic_app_action_custom":                resourceArmLogicAppActionCustom(),
-			"azurerm_logic_app_action_http":                  resourceArmLogicAppActionHTTP(),
-			"azurerm_logic_app_trigger_custom":               resourceArmLogicAppTriggerCustom(),
-			"azurerm_logic_app_trigger_http_request":         resourceArmLogicAppTriggerHttpRequest(),
-			"azurerm_logic_app_trigger_recurrence":           resourceArmLogicAppTriggerRecurrence(),
-			"azurerm_logic_app_workflow":                     resourceArmLogicAppWorkflow(),
-			"azurerm_managed_disk":                           resourceArmManagedDisk(),
-			"azurerm_management_group":                       resourceArmManagementGroup(),
-			"azurerm_management_lock":                        resourceArmManagementLock(),
-			"azurerm_mariadb_database":                       resourceArmMariaDbDatabase(),
-			"azurerm_mariadb_server":                         resourceArmMariaDbServer(),
-			"azurerm_media_services_account":                 resourceArmMediaServicesAccount(),
-			"azurerm_metric_alertrule":                       resourceArmMetricAlertRule(),
-			"azurerm_monitor_autoscale_setting":              resourceArmMonitorAutoScaleSetting(),
-			"azurerm_monitor_action_group":                   resourceArmMonitorActionGroup(),
-			"azurerm_monitor_activity_log_alert":             resourceArmMonitorActivityLogAlert(),
-			"azurerm_monitor_diagnostic_setting":             resourceArmMonitorDiagnosticSetting(),
-			"azurerm_monitor_log_profile":                    resourceArmMonitorLogProfile(),
-			"azurerm_monitor_metric_alert":                   resourceArmMonitorMetricAlert(),
-			"azurerm_monitor_metric_alertrule":               resourceArmMonitorMetricAlertRule(),
-			"azurerm_mssql_elasticpool":                      resourceArmMsSqlElasticPool(),
-			"azurerm_mysql_configuration":                    resourceArmMySQLConfiguration(),
-			"azurerm_mysql_database":                         resourceArmMySqlDatabase(),
-			"azurerm_mysql_firewall_rule":                    resourceArmMySqlFirewallRule(),
-			"azurerm_mysql_server":                           resourceArmMySqlServer(),
-			"azurerm_mysql_virtual_network_rule":             resourceArmMySqlVirtualNetworkRule(),
+			"azurerm_api_management":                            resourceArmApiManagementService(),
+			"azurerm_api_management_api":                        resourceArmApiManagementApi(),
+			"azurerm_api_management_api_operation":              resourceArmApiManagementApiOperation(),
+			"azurerm_api_management_group":                      resourceArmApiManagementGroup(),
+			"azurerm_api_management_group_user":                 resourceArmApiManagementGroupUser(),
+			"azurerm_api_management_logger":                     resourceArmApiManagementLogger(),
+			"azurerm_api_management_product":                    resourceArmApiManagementProduct(),
+			"azurerm_api_management_product_api":                resourceArmApiManagementProductApi(),
+			"azurerm_api_management_product_group":              resourceArmApiManagementProductGroup(),
+			"azurerm_api_management_property":                   resourceArmApiManagementProperty(),
+			"azurerm_api_management_subscription":               resourceArmApiManagementSubscription(),
+			"azurerm_api_management_user":                       resourceArmApiManagementUser(),
+			"azurerm_app_service_active_slot":                   resourceArmAppServiceActiveSlot(),
+			"azurerm_app_service_custom_hostname_binding":       resourceArmAppServiceCustomHostnameBinding(),
+			"azurerm_app_service_hybrid_connection":             resourceArmAppServiceHybridConnection(),
+			"azurerm_app_service_plan":                          resourceArmAppServicePlan(),
+			"azurerm_app_service_slot":                          resourceArmAppServiceSlot(),
+			"azurerm_app_service":                               resourceArmAppService(),
+			"azurerm_application_gateway":                       resourceArmApplicationGateway(),
+			"azurerm_application_insights_api_key":              resourceArmApplicationInsightsAPIKey(),
+			"azurerm_application_insights":                      resourceArmApplicationInsights(),
+			"azurerm_application_insights_smart_detection_rule": resourceArmApplicationInsightsSmartDetectionRule(),
+			"azurerm_application_insights_workbook":             resourceArmApplicationInsightsWorkbook(),
+			"azurerm_application_security_group":                resourceArmApplicationSecurityGroup(),
+			"azurerm_automation_account":                        resourceArmAutomationAccount(),
+			"azurerm_automation_credential":                     resourceArmAutomationCredential(),
+			"azurerm_automation_dsc_configuration":              resourceArmAutomationDscConfiguration(),
+			"azurerm_automation_dsc_nodeconfiguration":          resourceArmAutomationDscNodeConfiguration(),
+			"azurerm_automation_module":                         resourceArmAutomationModule(),
+			"azurerm_automation_runbook":                        resourceArmAutomationRunbook(),
+			"azurerm_automation_schedule":                       resourceArmAutomationSchedule(),
+			"azurerm_autoscale_setting":                         resourceArmAutoScaleSetting(),
+			"azurerm_availability_set":                          resourceArmAvailabilitySet(),
+			"azurerm_azuread_application":                       resourceArmActiveDirectoryApplication(),
+			"azurerm_azuread_service_principal_password":        resourceArmActiveDirectoryServicePrincipalPassword(),
+			"azurerm_azuread_service_principal":                 resourceArmActiveDirectoryServicePrincipal(),
+			"azurerm_batch_account":                             resourceArmBatchAccount(),
+			"azurerm_batch_pool":                                resourceArmBatchPool(),
+			"azurerm_cdn_endpoint":                              resourceArmCdnEndpoint(),
+			"azurerm_cdn_profile":                               resourceArmCdnProfile(),
+			"azurerm_cognitive_account":                         resourceArmCognitiveAccount(),
+			"azurerm_connection_monitor":                        resourceArmConnectionMonitor(),
+			"azurerm_container_group":                           resourceArmContainerGroup(),
+			"azurerm_container_registry":                        resourceArmContainerRegistry(),
+			"azurerm_container_service":                         resourceArmContainerService(),
+			"azurerm_cosmosdb_account":                          resourceArmCosmosDBAccount(),
+			"azurerm_data_lake_analytics_account":               resourceArmDataLakeAnalyticsAccount(),
+			"azurerm_data_lake_analytics_firewall_rule":         resourceArmDataLakeAnalyticsFirewallRule(),
+			"azurerm_data_lake_store_file":                      resourceArmDataLakeStoreFile(),
+			"azurerm_data_lake_store_firewall_rule":             resourceArmDataLakeStoreFirewallRule(),
+			"azurerm_data_lake_store":                           resourceArmDataLakeStore(),
+			"azurerm_databricks_workspace":                      resourceArmDatabricksWorkspace(),
+			"azurerm_ddos_protection_plan":                      resourceArmDDoSProtectionPlan(),
+			"azurerm_dev_test_lab":                              resourceArmDevTestLab(),
+			"azurerm_dev_test_linux_virtual_machine":            resourceArmDevTestLinuxVirtualMachine(),
+			"azurerm_dev_test_policy":                           resourceArmDevTestPolicy(),
+			"azurerm_dev_test_virtual_network":                  resourceArmDevTestVirtualNetwork(),
+			"azurerm_dev_test_windows_virtual_machine":          resourceArmDevTestWindowsVirtualMachine(),
+			"azurerm_devspace_controller":                       resourceArmDevSpaceController(),
+			"azurerm_dns_a_record":                              resourceArmDnsARecord(),
+			"azurerm_dns_aaaa_record":                           resourceArmDnsAAAARecord(),
+			"azurerm_dns_caa_record":                            resourceArmDnsCaaRecord(),
+			"azurerm_dns_cname_record":                          resourceArmDnsCNameRecord(),
+			"azurerm_dns_mx_record":                             resourceArmDnsMxRecord(),
+			"azurerm_dns_ns_record":                             resourceArmDnsNsRecord(),
+			"azurerm_dns_ptr_record":                            resourceArmDnsPtrRecord(),
+			"azurerm_dns_srv_record":                            resourceArmDnsSrvRecord(),
+			"azurerm_dns_txt_record":                            resourceArmDnsTxtRecord(),
+			"azurerm_dns_zone":                                  resourceArmDnsZone(),
+			"azurerm_dns_zone_delegation":                       resourceArmDnsZoneDelegation(),
+			"azurerm_eventgrid_domain":                          resourceArmEventGridDomain(),
+			"azurerm_eventgrid_event_subscription":              resourceArmEventGridEventSubscription(),
+			"azurerm_eventgrid_topic":                           resourceArmEventGridTopic(),
+			"azurerm_eventhub_authorization_rule":               resourceArmEventHubAuthorizationRule(),
+			"azurerm_eventhub_consumer_group":                   resourceArmEventHubConsumerGroup(),
+			"azurerm_eventhub_namespace_authorization_rule":     resourceArmEventHubNamespaceAuthorizationRule(),
+			"azurerm_eventhub_namespace":                        resourceArmEventHubNamespace(),
+			"azurerm_eventhub":                                  resourceArmEventHub(),
+			"azurerm_express_route_circuit_authorization":       resourceArmExpressRouteCircuitAuthorization(),
+			"azurerm_express_route_circuit_peering":             resourceArmExpressRouteCircuitPeering(),
+			"azurerm_express_route_circuit":                     resourceArmExpressRouteCircuit(),
+			"azurerm_firewall_application_rule_collection":      resourceArmFirewallApplicationRuleCollection(),
+			"azurerm_firewall_network_rule_collection":          resourceArmFirewallNetworkRuleCollection(),
+			"azurerm_firewall":                                  resourceArmFirewall(),
+			"azurerm_function_app":                              resourceArmFunctionApp(),
+			"azurerm_image":                                     resourceArmImage(),
+			"azurerm_iothub_consumer_group":                     resourceArmIotHubConsumerGroup(),
+			"azurerm_iothub":                                    resourceArmIotHub(),
+			"azurerm_key_vault_access_policy":                   resourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_certificate":                     resourceArmKeyVaultCertificate(),
+			"azurerm_key_vault_certificate_contacts":            resourceArmKeyVaultCertificateContacts(),
+			"azurerm_key_vault_key":                             resourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":                          resourceArmKeyVaultSecret(),
+			"azurerm_key_vault":                                 resourceArmKeyVault(),
+			"azurerm_kubernetes_cluster":                        resourceArmKubernetesCluster(),
+			"azurerm_lb_backend_address_pool":                   resourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_lb_nat_pool":                               resourceArmLoadBalancerNatPool(),
+			"azurerm_lb_nat_rule":                               resourceArmLoadBalancerNatRule(),
+			"azurerm_lb_probe":                                  resourceArmLoadBalancerProbe(),
+			"azurerm_lb_outbound_rule":                          resourceArmLoadBalancerOutboundRule(),
+			"azurerm_lb_rule":                                   resourceArmLoadBalancerRule(),
+			"azurerm_lb":                                        resourceArmLoadBalancer(),
+			"azurerm_linux_web_app":                             resourceArmLinuxWebApp(),
+			"azurerm_local_network_gateway":                     resourceArmLocalNetworkGateway(),
+			"azurerm_log_analytics_solution":                    resourceArmLogAnalyticsSolution(),
+			"azurerm_log_analytics_linked_service":              resourceArmLogAnalyticsLinkedService(),
+			"azurerm_log_analytics_workspace_linked_service":    resourceArmLogAnalyticsWorkspaceLinkedService(),
+			"azurerm_log_analytics_workspace":                   resourceArmLogAnalyticsWorkspace(),
+			"azurerm_logic_app_action_custom":                   resourceArmLogicAppActionCustom(),
+			"azurerm_logic_app_action_http":                     resourceArmLogicAppActionHTTP(),
+			"azurerm_logic_app_integration_account":             resourceArmLogicAppIntegrationAccount(),
+			"azurerm_logic_app_integration_account_map":         resourceArmLogicAppIntegrationAccountMap(),
+			"azurerm_logic_app_integration_account_partner":     resourceArmLogicAppIntegrationAccountPartner(),
+			"azurerm_logic_app_integration_account_schema":      resourceArmLogicAppIntegrationAccountSchema(),
+			"azurerm_logic_app_trigger_custom":                  resourceArmLogicAppTriggerCustom(),
+			"azurerm_logic_app_trigger_http_request":            resourceArmLogicAppTriggerHttpRequest(),
+			"azurerm_logic_app_trigger_recurrence":              resourceArmLogicAppTriggerRecurrence(),
+			"azurerm_logic_app_workflow":                        resourceArmLogicAppWorkflow(),
+			"azurerm_managed_disk":                              resourceArmManagedDisk(),
+			"azurerm_managed_disk_sas_token":                    resourceArmManagedDiskSasToken(),
+			"azurerm_management_group":                          resourceArmManagementGroup(),
+			"azurerm_management_lock":                           resourceArmManagementLock(),
+			"azurerm_mariadb_database":                          resourceArmMariaDbDatabase(),
+			"azurerm_mariadb_server":                            resourceArmMariaDbServer(),
+			"azurerm_media_services_account":                    resourceArmMediaServicesAccount(),
+			"azurerm_media_transform":                           resourceArmMediaTransform(),
+			"azurerm_metric_alertrule":                          resourceArmMetricAlertRule(),
+			"azurerm_monitor_autoscale_setting":                 resourceArmMonitorAutoScaleSetting(),
+			"azurerm_monitor_action_group":                      resourceArmMonitorActionGroup(),
+			"azurerm_monitor_activity_log_alert":                resourceArmMonitorActivityLogAlert(),
+			"azurerm_monitor_diagnostic_setting":                resourceArmMonitorDiagnosticSetting(),
+			"azurerm_monitor_log_profile":                       resourceArmMonitorLogProfile(),
+			"azurerm_monitor_metric_alert":                      resourceArmMonitorMetricAlert(),
+			"azurerm_monitor_metric_alertrule":                  resourceArmMonitorMetricAlertRule(),
+			"azurerm_mssql_elasticpool":                         resourceArmMsSqlElasticPool(),
+			"azurerm_mysql_configuration":                       resourceArmMySQLConfiguration(),
+			"azurerm_mysql_database":                            resourceArmMySqlDatabase(),
+			"azurerm_mysql_firewall_rule":                       resourceArmMySqlFirewallRule(),
+			"azurerm_mysql_server":                              resourceArmMySqlServer(),
+			"azurerm_mysql_virtual_network_rule":                resourceArmMySqlVirtualNetworkRule(),
 			"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 			"azurerm_network_interface_application_security_group_association":               resourceArmNetworkInterfaceApplicationSecurityGroupAssociation(),
 			"azurerm_network_interface_backend_address_pool_association":                     resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
 			"azurerm_network_interface_nat_rule_association":                                 resourceArmNetworkInterfaceNatRuleAssociation(),
 			"azurerm_network_interface":                                                      resourceArmNetworkInterface(),
 			"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
+			"azurerm_network_security_group_rules":                                           resourceArmNetworkSecurityGroupRules(),
 			"azurerm_network_security_rule":                                                  resourceArmNetworkSecurityRule(),
 			"azurerm_network_watcher":                                                        resourceArmNetworkWatcher(),
 			"azurerm_notification_hub_authorization_rule":                                    resourceArmNotificationHubAuthorizationRule(),
@@ -317,13 +377,17 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_postgresql_server":                                                      resourceArmPostgreSQLServer(),
 			"azurerm_postgresql_virtual_network_rule":                                        resourceArmPostgreSQLVirtualNetworkRule(),
 			"azurerm_public_ip":                                                              resourceArmPublicIp(),
+			"azurerm_recovery_services_protected_file_share":                                 resourceArmRecoveryServicesProtectedFileShare(),
 			"azurerm_recovery_services_protected_vm":                                         resourceArmRecoveryServicesProtectedVm(),
+			"azurerm_recovery_services_protection_policy_file_share":                         resourceArmRecoveryServicesProtectionPolicyFileShare(),
 			"azurerm_recovery_services_protection_policy_vm":                                 resourceArmRecoveryServicesProtectionPolicyVm(),
 			"azurerm_recovery_services_vault":                                                resourceArmRecoveryServicesVault(),
 			"azurerm_redis_cache":                                                            resourceArmRedisCache(),
 			"azurerm_redis_firewall_rule":                                                    resourceArmRedisFirewallRule(),
+			"azurerm_relay_hybrid_connection":                                                resourceArmRelayHybridConnection(),
 			"azurerm_relay_namespace":                                                        resourceArmRelayNamespace(),
 			"azurerm_resource_group":                                                         resourceArmResourceGroup(),
+			"azurerm_resource_group_move":                                                    resourceArmResourceGroupMove(),
 			"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
 			"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
 			"azurerm_route_table":                                                            resourceArmRouteTable(),
@@ -353,6 +417,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_sql_elasticpool":                                                        resourceArmSqlElasticPool(),
 			"azurerm_sql_firewall_rule":                                                      resourceArmSqlFirewallRule(),
 			"azurerm_sql_server":                                                             resourceArmSqlServer(),
+			"azurerm_sql_server_transparent_data_encryption":                                 resourceArmSqlServerTransparentDataEncryption(),
 			"azurerm_sql_virtual_network_rule":                                               resourceArmSqlVirtualNetworkRule(),
 			"azurerm_storage_account":                                                        resourceArmStorageAccount(),
 			"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
@@ -360,6 +425,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
 			"azurerm_storage_share":                                                          resourceArmStorageShare(),
 			"azurerm_storage_table":                                                          resourceArmStorageTable(),
+			"azurerm_storage_table_entity":                                                   resourceArmStorageTableEntity(),
 			"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 			"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
 			"azurerm_subnet":                                                                 resourceArmSubnet(),
@@ -375,6 +441,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_windows_web_app":                                                        resourceArmWindowsWebApp(),
 		},
 	}
 
@@ -409,14 +476,23 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, skipProviderRegistration, partnerId)
+		maxIdleConnsPerHost := d.Get("max_idle_connections_per_host").(int)
+		client, err := getArmClient(config, skipProviderRegistration, partnerId, maxIdleConnsPerHost)
 
 		if err != nil {
 			return nil, err
 		}
 
+		client.hashSensitiveValues = d.Get("hash_sensitive_values").(bool)
+		client.features = expandFeatures(d.Get("features").([]interface{}))
+		client.eventualConsistencyTimeout = time.Duration(d.Get("eventual_consistency_timeout_in_minutes").(int)) * time.Minute
 		client.StopContext = p.StopContext()
 
+		if v := d.Get("correlation_request_id").(string); v != "" {
+			client.correlationRequestId = v
+		}
+		log.Printf("[INFO] Using Correlation Request ID %q for requests to Azure", client.correlationRequestId)
+
 		// replaces the context between tests
 		p.MetaReset = func() error {
 			client.StopContext = p.StopContext()
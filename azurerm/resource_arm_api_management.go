@@ -5,6 +5,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -166,6 +167,21 @@ func resourceArmApiManagementService() *schema.Resource {
 								string(apimanagement.Root),
 							}, false),
 						},
+
+						"expiry": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"thumbprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -298,7 +314,7 @@ func resourceArmApiManagementServiceCreateUpdate(d *schema.ResourceData, meta in
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing API Management Service %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing API Management Service %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -347,16 +363,17 @@ func resourceArmApiManagementServiceCreateUpdate(d *schema.ResourceData, meta in
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error creating/updating API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating/updating API Management Service %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation/update of API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	description := fmt.Sprintf("API Management Service %q (Resource Group %q) to be created/updated", name, resourceGroup)
+	if err = azure.WaitForCompletionWithProgress(ctx, &future.Future, client.Client, description); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of API Management Service %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -388,7 +405,7 @@ func resourceArmApiManagementServiceRead(d *schema.ResourceData, meta interface{
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on API Management Service %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", name)
@@ -423,6 +440,10 @@ func resourceArmApiManagementServiceRead(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("Error setting `hostname_configuration`: %+v", err)
 		}
 
+		if err := d.Set("certificate", flattenApiManagementCertificates(props.Certificates, d)); err != nil {
+			return fmt.Errorf("Error setting `certificate`: %+v", err)
+		}
+
 		if err := d.Set("additional_location", flattenApiManagementAdditionalLocations(props.AdditionalLocations)); err != nil {
 			return fmt.Errorf("Error setting `additional_location`: %+v", err)
 		}
@@ -452,7 +473,7 @@ func resourceArmApiManagementServiceDelete(d *schema.ResourceData, meta interfac
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error deleting API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error deleting API Management Service %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
@@ -621,6 +642,81 @@ func expandAzureRmApiManagementCertificates(d *schema.ResourceData) *[]apimanage
 	return &results
 }
 
+func flattenApiManagementCertificates(input *[]apimanagement.CertificateConfiguration, d *schema.ResourceData) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	// the certificate and password aren't returned by the API, so these are loaded from the matching certificate in the state
+	// - which is matched on the store and thumbprint, such that any certificates removed outside of Terraform show up as a diff
+	existing := d.Get("certificate").([]interface{})
+	matched := make(map[int]bool)
+
+	for i, v := range *input {
+		output := map[string]interface{}{
+			"store_name": string(v.StoreName),
+		}
+
+		thumbprint := ""
+		if info := v.Certificate; info != nil {
+			if info.Expiry != nil {
+				output["expiry"] = info.Expiry.Format(time.RFC3339)
+			}
+			if info.Subject != nil {
+				output["subject"] = *info.Subject
+			}
+			if info.Thumbprint != nil {
+				thumbprint = *info.Thumbprint
+			}
+		}
+		output["thumbprint"] = thumbprint
+
+		match := -1
+		for j, raw := range existing {
+			if matched[j] || raw == nil {
+				continue
+			}
+
+			old := raw.(map[string]interface{})
+			if !strings.EqualFold(old["store_name"].(string), string(v.StoreName)) {
+				continue
+			}
+
+			if existingThumbprint := old["thumbprint"].(string); existingThumbprint != "" && strings.EqualFold(existingThumbprint, thumbprint) {
+				match = j
+				break
+			}
+		}
+
+		// the thumbprint won't be in the state when upgrading from an older version of the provider, in which case
+		// the certificates are matched by their position (since they're returned in the order they were submitted)
+		if match == -1 && i < len(existing) && !matched[i] && existing[i] != nil {
+			old := existing[i].(map[string]interface{})
+			if old["thumbprint"].(string) == "" && strings.EqualFold(old["store_name"].(string), string(v.StoreName)) {
+				match = i
+			}
+		}
+
+		if match != -1 {
+			old := existing[match].(map[string]interface{})
+			output["encoded_certificate"] = old["encoded_certificate"]
+			output["certificate_password"] = old["certificate_password"]
+			matched[match] = true
+		}
+
+		results = append(results, output)
+	}
+
+	for i := range existing {
+		if !matched[i] {
+			log.Printf("[DEBUG] `certificate.%d` of API Management Service %q was not found - it's been removed outside of Terraform", i, d.Get("name").(string))
+		}
+	}
+
+	return results
+}
+
 func expandAzureRmApiManagementAdditionalLocations(d *schema.ResourceData, sku *apimanagement.ServiceSkuProperties) *[]apimanagement.AdditionalLocation {
 	inputLocations := d.Get("additional_location").([]interface{})
 
@@ -78,7 +78,7 @@ func resourceArmApiManagementGroupCreateUpdate(d *schema.ResourceData, meta inte
 		existing, err := client.Get(ctx, resourceGroup, serviceName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Group %q (API Management Service %q / Resource Group %q): %s", name, serviceName, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Group %q (API Management Service %q / Resource Group %q): %s", name, serviceName, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -97,12 +97,12 @@ func resourceArmApiManagementGroupCreateUpdate(d *schema.ResourceData, meta inte
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, name, parameters, ""); err != nil {
-		return fmt.Errorf("Error creating or updating Group %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+		return fmt.Errorf("Error creating or updating Group %q (Resource Group %q / API Management Service %q): %s", name, resourceGroup, serviceName, azure.FormatError(err))
 	}
 
 	resp, err := client.Get(ctx, resourceGroup, serviceName, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Group %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+		return fmt.Errorf("Error retrieving Group %q (Resource Group %q / API Management Service %q): %s", name, resourceGroup, serviceName, azure.FormatError(err))
 	}
 	if resp.ID == nil {
 		return fmt.Errorf("Cannot read ID for Group %q (Resource Group %q / API Management Service %q)", name, resourceGroup, serviceName)
@@ -132,7 +132,7 @@ func resourceArmApiManagementGroupRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request for Group %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+		return fmt.Errorf("Error making Read request for Group %q (Resource Group %q / API Management Service %q): %s", name, resourceGroup, serviceName, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -163,7 +163,7 @@ func resourceArmApiManagementGroupDelete(d *schema.ResourceData, meta interface{
 
 	if resp, err := client.Delete(ctx, resourceGroup, serviceName, name, ""); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error deleting Group %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+			return fmt.Errorf("Error deleting Group %q (Resource Group %q / API Management Service %q): %s", name, resourceGroup, serviceName, azure.FormatError(err))
 		}
 	}
 
@@ -116,12 +116,12 @@ func resourceArmApiManagementLoggerCreate(d *schema.ResourceData, meta interface
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, name, parameters, ""); err != nil {
-		return fmt.Errorf("Error creating Logger %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+		return fmt.Errorf("Error creating Logger %q (Resource Group %q / API Management Service %q): %s", name, resourceGroup, serviceName, azure.FormatError(err))
 	}
 
 	resp, err := client.Get(ctx, resourceGroup, serviceName, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Logger %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+		return fmt.Errorf("Error retrieving Logger %q (Resource Group %q / API Management Service %q): %s", name, resourceGroup, serviceName, azure.FormatError(err))
 	}
 	if resp.ID == nil {
 		return fmt.Errorf("Cannot read Logger %q (Resource Group %q / API Management Service %q) ID", name, resourceGroup, serviceName)
@@ -150,7 +150,7 @@ func resourceArmApiManagementLoggerRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading Logger %q (API Management Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+		return fmt.Errorf("Error reading Logger %q (API Management Service %q / Resource Group %q): %s", name, serviceName, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -195,7 +195,7 @@ func resourceArmApiManagementLoggerUpdate(d *schema.ResourceData, meta interface
 	}
 
 	if _, err := client.Update(ctx, resourceGroup, serviceName, name, parameters, ""); err != nil {
-		return fmt.Errorf("Error updating Logger %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+		return fmt.Errorf("Error updating Logger %q (Resource Group %q / API Management Service %q): %s", name, resourceGroup, serviceName, azure.FormatError(err))
 	}
 
 	return resourceArmApiManagementLoggerRead(d, meta)
@@ -215,7 +215,7 @@ func resourceArmApiManagementLoggerDelete(d *schema.ResourceData, meta interface
 
 	if resp, err := client.Delete(ctx, resourceGroup, serviceName, name, ""); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error deleting Logger %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+			return fmt.Errorf("Error deleting Logger %q (Resource Group %q / API Management Service %q): %s", name, resourceGroup, serviceName, azure.FormatError(err))
 		}
 	}
 
@@ -90,7 +90,7 @@ func resourceArmApiManagementProductCreateUpdate(d *schema.ResourceData, meta in
 		existing, err := client.Get(ctx, resourceGroup, serviceName, productId)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Product %q (API Management Service %q / Resource Group %q): %s", productId, serviceName, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Product %q (API Management Service %q / Resource Group %q): %s", productId, serviceName, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -121,12 +121,12 @@ func resourceArmApiManagementProductCreateUpdate(d *schema.ResourceData, meta in
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, productId, properties, ""); err != nil {
-		return fmt.Errorf("Error creating/updating Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+		return fmt.Errorf("Error creating/updating Product %q (API Management Service %q / Resource Group %q): %s", productId, serviceName, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, serviceName, productId)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Product %q (API Management Service %q / Resource Group %q): %s", productId, serviceName, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -159,7 +159,7 @@ func resourceArmApiManagementProductRead(d *schema.ResourceData, meta interface{
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Product %q (API Management Service %q / Resource Group %q): %s", productId, serviceName, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("product_id", productId)
@@ -196,7 +196,7 @@ func resourceArmApiManagementProductDelete(d *schema.ResourceData, meta interfac
 	resp, err := client.Delete(ctx, resourceGroup, serviceName, productId, "", utils.Bool(deleteSubscriptions))
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error deleting Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+			return fmt.Errorf("Error deleting Product %q (API Management Service %q / Resource Group %q): %s", productId, serviceName, resourceGroup, azure.FormatError(err))
 		}
 	}
 
@@ -44,7 +44,7 @@ func resourceArmApiManagementProductGroupCreate(d *schema.ResourceData, meta int
 		resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, productId, groupName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(resp) {
-				return fmt.Errorf("Error checking for present of existing Product %q / Group %q (API Management Service %q / Resource Group %q): %+v", productId, groupName, serviceName, resourceGroup, err)
+				return fmt.Errorf("Error checking for present of existing Product %q / Group %q (API Management Service %q / Resource Group %q): %s", productId, groupName, serviceName, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -57,7 +57,7 @@ func resourceArmApiManagementProductGroupCreate(d *schema.ResourceData, meta int
 
 	resp, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, productId, groupName)
 	if err != nil {
-		return fmt.Errorf("Error adding Product %q to Group %q (API Management Service %q / Resource Group %q): %+v", productId, groupName, serviceName, resourceGroup, err)
+		return fmt.Errorf("Error adding Product %q to Group %q (API Management Service %q / Resource Group %q): %s", productId, groupName, serviceName, resourceGroup, azure.FormatError(err))
 	}
 
 	// there's no Read so this is best-effort
@@ -87,7 +87,7 @@ func resourceArmApiManagementProductGroupRead(d *schema.ResourceData, meta inter
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Product %q / Group %q (API Management Service %q / Resource Group %q): %+v", productId, groupName, serviceName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Product %q / Group %q (API Management Service %q / Resource Group %q): %s", productId, groupName, serviceName, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("group_name", groupName)
@@ -113,7 +113,7 @@ func resourceArmApiManagementProductGroupDelete(d *schema.ResourceData, meta int
 
 	if resp, err := client.Delete(ctx, resourceGroup, serviceName, productId, groupName); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error removing Product %q from Group %q (API Management Service %q / Resource Group %q): %+v", productId, groupName, serviceName, resourceGroup, err)
+			return fmt.Errorf("Error removing Product %q from Group %q (API Management Service %q / Resource Group %q): %s", productId, groupName, serviceName, resourceGroup, azure.FormatError(err))
 		}
 	}
 
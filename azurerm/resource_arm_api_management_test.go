@@ -105,6 +105,8 @@ func TestAccAzureRMApiManagement_complete(t *testing.T) {
 					testCheckAzureRMApiManagementExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "tags.Acceptance", "Test"),
 					resource.TestCheckResourceAttrSet(resourceName, "public_ip_addresses.#"),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate.0.thumbprint"),
 				),
 			},
 			{
@@ -112,7 +114,10 @@ func TestAccAzureRMApiManagement_complete(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"certificate", // not returned from API, sensitive
+					"certificate.0.encoded_certificate",                      // not returned from API, sensitive
+					"certificate.0.certificate_password",                     // not returned from API, sensitive
+					"certificate.1.encoded_certificate",                      // not returned from API, sensitive
+					"certificate.1.certificate_password",                     // not returned from API, sensitive
 					"hostname_configuration.0.portal.0.certificate",          // not returned from API, sensitive
 					"hostname_configuration.0.portal.0.certificate_password", // not returned from API, sensitive
 					"hostname_configuration.0.proxy.0.certificate",           // not returned from API, sensitive
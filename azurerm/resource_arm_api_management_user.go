@@ -104,7 +104,7 @@ func resourceArmApiManagementUserCreateUpdate(d *schema.ResourceData, meta inter
 		existing, err := client.Get(ctx, resourceGroup, serviceName, userId)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing User %q (API Management Service %q / Resource Group %q): %s", userId, serviceName, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing User %q (API Management Service %q / Resource Group %q): %s", userId, serviceName, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -136,12 +136,12 @@ func resourceArmApiManagementUserCreateUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, userId, properties, ""); err != nil {
-		return fmt.Errorf("Error creating/updating User %q (API Management Service %q / Resource Group %q): %+v", userId, serviceName, resourceGroup, err)
+		return fmt.Errorf("Error creating/updating User %q (API Management Service %q / Resource Group %q): %s", userId, serviceName, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, serviceName, userId)
 	if err != nil {
-		return fmt.Errorf("Error retrieving User %q (API Management Service %q / Resource Group %q): %+v", userId, serviceName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving User %q (API Management Service %q / Resource Group %q): %s", userId, serviceName, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -174,7 +174,7 @@ func resourceArmApiManagementUserRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on User %q (API Management Service %q / Resource Group %q): %+v", userId, serviceName, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on User %q (API Management Service %q / Resource Group %q): %s", userId, serviceName, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("user_id", userId)
@@ -210,7 +210,7 @@ func resourceArmApiManagementUserDelete(d *schema.ResourceData, meta interface{}
 	resp, err := client.Delete(ctx, resourceGroup, serviceName, userId, "", deleteSubscriptions, notify)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error deleting User %q (API Management Service %q / Resource Group %q): %+v", userId, serviceName, resourceGroup, err)
+			return fmt.Errorf("Error deleting User %q (API Management Service %q / Resource Group %q): %s", userId, serviceName, resourceGroup, azure.FormatError(err))
 		}
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -63,8 +64,9 @@ func resourceArmAppService() *schema.Resource {
 			"location": locationSchema(),
 
 			"app_service_plan_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"site_config": azure.SchemaAppServiceSiteConfig(),
@@ -203,7 +205,7 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing App Service %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing App Service %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -218,7 +220,7 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	available, err := client.CheckNameAvailability(ctx, availabilityRequest)
 	if err != nil {
-		return fmt.Errorf("Error checking if the name %q was available: %+v", name, err)
+		return fmt.Errorf("Error checking if the name %q was available: %s", name, azure.FormatError(err))
 	}
 
 	if !*available.NameAvailable {
@@ -334,7 +336,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if _, err := client.CreateOrUpdateConfiguration(ctx, resGroup, name, siteConfigResource); err != nil {
-			return fmt.Errorf("Error updating Configuration for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Configuration for App Service %q: %s", name, azure.FormatError(err))
 		}
 	}
 
@@ -350,7 +352,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if _, err := client.Update(ctx, resGroup, name, sitePatchResource); err != nil {
-			return fmt.Errorf("Error updating App Service ARR Affinity setting %q: %+v", name, err)
+			return fmt.Errorf("Error updating App Service ARR Affinity setting %q: %s", name, azure.FormatError(err))
 		}
 	}
 
@@ -362,7 +364,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if _, err := client.UpdateApplicationSettings(ctx, resGroup, name, settings); err != nil {
-			return fmt.Errorf("Error updating Application Settings for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Application Settings for App Service %q: %s", name, azure.FormatError(err))
 		}
 	}
 
@@ -374,14 +376,14 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if _, err := client.UpdateConnectionStrings(ctx, resGroup, name, properties); err != nil {
-			return fmt.Errorf("Error updating Connection Strings for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Connection Strings for App Service %q: %s", name, azure.FormatError(err))
 		}
 	}
 
 	if d.HasChange("identity") {
 		site, err := client.Get(ctx, resGroup, name)
 		if err != nil {
-			return fmt.Errorf("Error getting configuration for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error getting configuration for App Service %q: %s", name, azure.FormatError(err))
 		}
 
 		appServiceIdentity := expandAzureRmAppServiceIdentity(d)
@@ -390,11 +392,11 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		future, err := client.CreateOrUpdate(ctx, resGroup, name, site)
 
 		if err != nil {
-			return fmt.Errorf("Error updating Managed Service Identity for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Managed Service Identity for App Service %q: %s", name, azure.FormatError(err))
 		}
 
 		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Error updating Managed Service Identity for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Managed Service Identity for App Service %q: %s", name, azure.FormatError(err))
 		}
 	}
 
@@ -420,27 +422,27 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %s", name, azure.FormatError(err))
 	}
 
 	configResp, err := client.GetConfiguration(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Configuration %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Configuration %q: %s", name, azure.FormatError(err))
 	}
 
 	appSettingsResp, err := client.ListApplicationSettings(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service AppSettings %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service AppSettings %q: %s", name, azure.FormatError(err))
 	}
 
 	connectionStringsResp, err := client.ListConnectionStrings(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service ConnectionStrings %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service ConnectionStrings %q: %s", name, azure.FormatError(err))
 	}
 
 	scmResp, err := client.GetSourceControl(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Source Control %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Source Control %q: %s", name, azure.FormatError(err))
 	}
 
 	siteCredFuture, err := client.ListPublishingCredentials(ctx, resGroup, name)
@@ -453,7 +455,7 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	siteCredResp, err := siteCredFuture.Result(client)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Site Credential %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Site Credential %q: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", name)
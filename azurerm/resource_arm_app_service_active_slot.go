@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -50,7 +51,7 @@ func resourceArmAppServiceActiveSlotCreateUpdate(d *schema.ResourceData, meta in
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("[DEBUG] App Service %q (resource group %q) was not found.", appServiceName, resGroup)
 		}
-		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", appServiceName, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %s", appServiceName, azure.FormatError(err))
 	}
 
 	_, err = client.Get(ctx, resGroup, targetSlot)
@@ -58,7 +59,7 @@ func resourceArmAppServiceActiveSlotCreateUpdate(d *schema.ResourceData, meta in
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("[DEBUG] App Service Target Active Slot %q/%q (resource group %q) was not found.", appServiceName, targetSlot, resGroup)
 		}
-		return fmt.Errorf("Error making Read request on AzureRM App Service Slot %q/%q: %+v", appServiceName, targetSlot, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot %q/%q: %s", appServiceName, targetSlot, azure.FormatError(err))
 	}
 
 	cmsSlotEntity := web.CsmSlotEntity{
@@ -68,10 +69,10 @@ func resourceArmAppServiceActiveSlotCreateUpdate(d *schema.ResourceData, meta in
 
 	future, err := client.SwapSlotWithProduction(ctx, resGroup, appServiceName, cmsSlotEntity)
 	if err != nil {
-		return fmt.Errorf("Error swapping App Service Slot %q/%q: %+v", appServiceName, targetSlot, err)
+		return fmt.Errorf("Error swapping App Service Slot %q/%q: %s", appServiceName, targetSlot, azure.FormatError(err))
 	}
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error swapping App Service Slot %q/%q: %+v", appServiceName, targetSlot, err)
+		return fmt.Errorf("Error swapping App Service Slot %q/%q: %s", appServiceName, targetSlot, azure.FormatError(err))
 	}
 	d.SetId(*resp.ID)
 	return resourceArmAppServiceActiveSlotRead(d, meta)
@@ -96,7 +97,7 @@ func resourceArmAppServiceActiveSlotRead(d *schema.ResourceData, meta interface{
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("app_service_name", resp.Name)
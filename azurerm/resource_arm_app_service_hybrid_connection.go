@@ -0,0 +1,250 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var appServiceHybridConnectionResourceName = "azurerm_app_service_hybrid_connection"
+
+func resourceArmAppServiceHybridConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceHybridConnectionCreateUpdate,
+		Read:   resourceArmAppServiceHybridConnectionRead,
+		Update: resourceArmAppServiceHybridConnectionCreateUpdate,
+		Delete: resourceArmAppServiceHybridConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"relay_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"hostname": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.PortNumber,
+			},
+
+			"send_key_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RootManageSharedAccessKey",
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"relay_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"send_key_value": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"service_bus_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceHybridConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	relayNamespacesClient := meta.(*ArmClient).relayNamespacesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for App Service Hybrid Connection creation/update.")
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	appServiceName := d.Get("app_service_name").(string)
+	relayId := d.Get("relay_id").(string)
+	sendKeyName := d.Get("send_key_name").(string)
+
+	relay, err := parseAzureResourceID(relayId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `relay_id`: %+v", err)
+	}
+	namespaceName := relay.Path["namespaces"]
+	relayName := relay.Path["hybridConnections"]
+	if namespaceName == "" || relayName == "" {
+		return fmt.Errorf("`relay_id` must be the ID of a Relay Hybrid Connection, got %q", relayId)
+	}
+
+	azureRMLockByName(appServiceName, appServiceHybridConnectionResourceName)
+	defer azureRMUnlockByName(appServiceName, appServiceHybridConnectionResourceName)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q): %s", relayName, namespaceName, appServiceName, resourceGroup, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError(appServiceHybridConnectionResourceName, *existing.ID)
+		}
+	}
+
+	// the Send Key is a Shared Access Key on the Relay Namespace, which the App Service uses to connect to the Relay
+	keys, err := relayNamespacesClient.ListKeys(ctx, relay.ResourceGroup, namespaceName, sendKeyName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Send Key %q for Relay Namespace %q (Resource Group %q): %s", sendKeyName, namespaceName, relay.ResourceGroup, azure.FormatError(err))
+	}
+	if keys.PrimaryKey == nil {
+		return fmt.Errorf("Error retrieving Send Key %q for Relay Namespace %q (Resource Group %q): `primaryKey` was nil", sendKeyName, namespaceName, relay.ResourceGroup)
+	}
+
+	properties := web.HybridConnection{
+		HybridConnectionProperties: &web.HybridConnectionProperties{
+			ServiceBusNamespace: utils.String(namespaceName),
+			RelayName:           utils.String(relayName),
+			RelayArmURI:         utils.String(relayId),
+			Hostname:            utils.String(d.Get("hostname").(string)),
+			Port:                utils.Int32(int32(d.Get("port").(int))),
+			SendKeyName:         utils.String(sendKeyName),
+			SendKeyValue:        keys.PrimaryKey,
+			ServiceBusSuffix:    utils.String(fmt.Sprintf(".%s", meta.(*ArmClient).environment.ServiceBusEndpointSuffix)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName, properties); err != nil {
+		return fmt.Errorf("Error creating/updating Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q): %s", relayName, namespaceName, appServiceName, resourceGroup, azure.FormatError(err))
+	}
+
+	read, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q): %s", relayName, namespaceName, appServiceName, resourceGroup, azure.FormatError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q) ID", relayName, namespaceName, appServiceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceHybridConnectionRead(d, meta)
+}
+
+func resourceArmAppServiceHybridConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	namespaceName := id.Path["hybridConnectionNamespaces"]
+	relayName := id.Path["relays"]
+
+	resp, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q) was not found - removing from state", relayName, namespaceName, appServiceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q): %s", relayName, namespaceName, appServiceName, resourceGroup, azure.FormatError(err))
+	}
+
+	d.Set("app_service_name", appServiceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.HybridConnectionProperties; props != nil {
+		d.Set("relay_id", props.RelayArmURI)
+		d.Set("hostname", props.Hostname)
+		d.Set("port", props.Port)
+		d.Set("send_key_name", props.SendKeyName)
+		d.Set("namespace_name", props.ServiceBusNamespace)
+		d.Set("relay_name", props.RelayName)
+		d.Set("service_bus_suffix", props.ServiceBusSuffix)
+
+		// the Send Key Value isn't returned by default, so we retrieve it from the Relay Namespace
+		if props.SendKeyValue != nil && *props.SendKeyValue != "" {
+			d.Set("send_key_value", props.SendKeyValue)
+		} else if relayId := props.RelayArmURI; relayId != nil && props.SendKeyName != nil {
+			relay, err := parseAzureResourceID(*relayId)
+			if err != nil {
+				return fmt.Errorf("Error parsing Relay ID %q: %+v", *relayId, err)
+			}
+
+			relayNamespacesClient := meta.(*ArmClient).relayNamespacesClient
+			keys, err := relayNamespacesClient.ListKeys(ctx, relay.ResourceGroup, relay.Path["namespaces"], *props.SendKeyName)
+			if err != nil {
+				log.Printf("[WARN] Error retrieving Send Key %q for Relay Namespace %q (Resource Group %q): %+v", *props.SendKeyName, relay.Path["namespaces"], relay.ResourceGroup, err)
+			} else {
+				d.Set("send_key_value", keys.PrimaryKey)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmAppServiceHybridConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	namespaceName := id.Path["hybridConnectionNamespaces"]
+	relayName := id.Path["relays"]
+
+	azureRMLockByName(appServiceName, appServiceHybridConnectionResourceName)
+	defer azureRMUnlockByName(appServiceName, appServiceHybridConnectionResourceName)
+
+	log.Printf("[DEBUG] Deleting Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q)", relayName, namespaceName, appServiceName, resourceGroup)
+
+	resp, err := client.DeleteHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q): %s", relayName, namespaceName, appServiceName, resourceGroup, azure.FormatError(err))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppServiceHybridConnection_basic(t *testing.T) {
+	resourceName := "azurerm_app_service_hybrid_connection.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceHybridConnection_basic(ri, testLocation(), 8080),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "relay_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "send_key_value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceHybridConnection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_app_service_hybrid_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceHybridConnection_basic(ri, location, 8080),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceHybridConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMAppServiceHybridConnection_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_app_service_hybrid_connection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceHybridConnection_update(t *testing.T) {
+	resourceName := "azurerm_app_service_hybrid_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceHybridConnection_basic(ri, location, 8080),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port", "8080"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServiceHybridConnection_basic(ri, location, 8081),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port", "8081"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceHybridConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		relayName := rs.Primary.Attributes["relay_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).appServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q) does not exist", relayName, namespaceName, appServiceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appServicesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppServiceHybridConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServicesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_hybrid_connection" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		relayName := rs.Primary.Attributes["relay_name"]
+
+		resp, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q) still exists", relayName, namespaceName, appServiceName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAppServiceHybridConnection_basic(rInt int, location string, port int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard"
+  }
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctestrhc-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  relay_namespace_name = "${azurerm_relay_namespace.test.name}"
+}
+
+resource "azurerm_app_service_hybrid_connection" "test" {
+  app_service_name    = "${azurerm_app_service.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  relay_id            = "${azurerm_relay_hybrid_connection.test.id}"
+  hostname            = "database.internal.example.com"
+  port                = %d
+}
+`, rInt, location, rInt, rInt, rInt, rInt, port)
+}
+
+func testAccAzureRMAppServiceHybridConnection_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_hybrid_connection" "import" {
+  app_service_name    = "${azurerm_app_service_hybrid_connection.test.app_service_name}"
+  resource_group_name = "${azurerm_app_service_hybrid_connection.test.resource_group_name}"
+  relay_id            = "${azurerm_app_service_hybrid_connection.test.relay_id}"
+  hostname            = "${azurerm_app_service_hybrid_connection.test.hostname}"
+  port                = "${azurerm_app_service_hybrid_connection.test.port}"
+}
+`, testAccAzureRMAppServiceHybridConnection_basic(rInt, location, 8080))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -85,12 +86,13 @@ func resourceArmAppServicePlan() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"app_service_environment_id": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							ForceNew:      true,
-							Computed:      true,
-							Deprecated:    "This property has been moved to the top level",
-							ConflictsWith: []string{"app_service_environment_id"},
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Computed:         true,
+							Deprecated:       "This property has been moved to the top level",
+							ConflictsWith:    []string{"app_service_environment_id"},
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"reserved": {
@@ -113,11 +115,12 @@ func resourceArmAppServicePlan() *schema.Resource {
 			},
 
 			"app_service_environment_id": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Computed:      true,
-				ConflictsWith: []string{"properties.0.app_service_environment_id"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"properties.0.app_service_environment_id"},
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"per_site_scaling": {
@@ -157,7 +160,7 @@ func resourceArmAppServicePlanCreateUpdate(d *schema.ResourceData, meta interfac
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing App Service Plan %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing App Service Plan %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -197,16 +200,16 @@ func resourceArmAppServicePlanCreateUpdate(d *schema.ResourceData, meta interfac
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, appServicePlan)
 	if err != nil {
-		return fmt.Errorf("Error creating/updating App Service Plan %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error creating/updating App Service Plan %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the create/update of App Service Plan %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error waiting for the create/update of App Service Plan %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving App Service Plan %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving App Service Plan %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 	if read.ID == nil {
 		return fmt.Errorf("Cannot read AzureRM App Service Plan %q (resource group %q) ID", name, resGroup)
@@ -239,7 +242,7 @@ func resourceArmAppServicePlanRead(d *schema.ResourceData, meta interface{}) err
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on App Service Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on App Service Plan %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", name)
@@ -291,7 +294,7 @@ func resourceArmAppServicePlanDelete(d *schema.ResourceData, meta interface{}) e
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error deleting App Service Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error deleting App Service Plan %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -68,9 +69,10 @@ func resourceArmAppServiceSlot() *schema.Resource {
 			},
 
 			"app_service_plan_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"site_config": azure.SchemaAppServiceSiteConfig(),
@@ -160,7 +162,7 @@ func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) e
 		existing, err := client.GetSlot(ctx, resGroup, appServiceName, slot)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Slot %q (App Service %q / Resource Group %q): %s", slot, appServiceName, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Slot %q (App Service %q / Resource Group %q): %s", slot, appServiceName, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -202,7 +204,7 @@ func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) e
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("[DEBUG] App Service %q (resource group %q) was not found.", appServiceName, resGroup)
 		}
-		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", appServiceName, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %s", appServiceName, azure.FormatError(err))
 	}
 
 	createFuture, err := client.CreateOrUpdateSlot(ctx, resGroup, appServiceName, siteEnvelope, slot)
@@ -277,7 +279,7 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 			SiteConfig: &siteConfig,
 		}
 		if _, err := client.CreateOrUpdateConfigurationSlot(ctx, resGroup, appServiceName, siteConfigResource, slot); err != nil {
-			return fmt.Errorf("Error updating Configuration for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+			return fmt.Errorf("Error updating Configuration for App Service Slot %q/%q: %s", appServiceName, slot, azure.FormatError(err))
 		}
 	}
 
@@ -291,7 +293,7 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 		_, err := client.UpdateSlot(ctx, resGroup, appServiceName, sitePatchResource, slot)
 		if err != nil {
-			return fmt.Errorf("Error updating App Service ARR Affinity setting %q: %+v", slot, err)
+			return fmt.Errorf("Error updating App Service ARR Affinity setting %q: %s", slot, azure.FormatError(err))
 		}
 	}
 
@@ -303,7 +305,7 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.UpdateApplicationSettingsSlot(ctx, resGroup, appServiceName, settings, slot); err != nil {
-			return fmt.Errorf("Error updating Application Settings for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+			return fmt.Errorf("Error updating Application Settings for App Service Slot %q/%q: %s", appServiceName, slot, azure.FormatError(err))
 		}
 	}
 
@@ -315,7 +317,7 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.UpdateConnectionStringsSlot(ctx, resGroup, appServiceName, properties, slot); err != nil {
-			return fmt.Errorf("Error updating Connection Strings for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+			return fmt.Errorf("Error updating Connection Strings for App Service Slot %q/%q: %s", appServiceName, slot, azure.FormatError(err))
 		}
 	}
 
@@ -342,22 +344,22 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM App Service Slot %q/%q: %+v", appServiceName, slot, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot %q/%q: %s", appServiceName, slot, azure.FormatError(err))
 	}
 
 	configResp, err := client.GetConfigurationSlot(ctx, resGroup, appServiceName, slot)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Slot Configuration %q/%q: %+v", appServiceName, slot, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot Configuration %q/%q: %s", appServiceName, slot, azure.FormatError(err))
 	}
 
 	appSettingsResp, err := client.ListApplicationSettingsSlot(ctx, resGroup, appServiceName, slot)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Slot AppSettings %q/%q: %+v", appServiceName, slot, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot AppSettings %q/%q: %s", appServiceName, slot, azure.FormatError(err))
 	}
 
 	connectionStringsResp, err := client.ListConnectionStringsSlot(ctx, resGroup, appServiceName, slot)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Slot ConnectionStrings %q/%q: %+v", appServiceName, slot, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot ConnectionStrings %q/%q: %s", appServiceName, slot, azure.FormatError(err))
 	}
 
 	d.Set("name", slot)
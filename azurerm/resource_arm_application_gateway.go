@@ -231,9 +231,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"private_ip_address": {
@@ -243,9 +244,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"public_ip_address_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"private_ip_address_allocation": {
@@ -302,9 +304,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"id": {
@@ -613,10 +616,12 @@ func resourceArmApplicationGateway() *schema.Resource {
 				},
 			},
 
-			// TODO: @tombuildsstuff deprecate this in favour of a full `ssl_protocol` block in the future
 			"disabled_ssl_protocols": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "has been replaced by `ssl_policy.0.disabled_protocols`",
+				ConflictsWith: []string{"ssl_policy"},
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
 					DiffSuppressFunc: suppress.CaseDifference,
@@ -628,6 +633,70 @@ func resourceArmApplicationGateway() *schema.Resource {
 				},
 			},
 
+			"ssl_policy": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"disabled_ssl_protocols"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disabled_protocols": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								DiffSuppressFunc: suppress.CaseDifference,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(network.TLSv10),
+									string(network.TLSv11),
+									string(network.TLSv12),
+								}, true),
+							},
+						},
+
+						"policy_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Custom),
+								string(network.Predefined),
+							}, false),
+						},
+
+						"policy_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.AppGwSslPolicy20150501),
+								string(network.AppGwSslPolicy20170401),
+								string(network.AppGwSslPolicy20170401S),
+							}, false),
+						},
+
+						"cipher_suites": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateApplicationGatewaySslCipherSuite,
+							},
+						},
+
+						"min_protocol_version": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.TLSv10),
+								string(network.TLSv11),
+								string(network.TLSv12),
+							}, true),
+						},
+					},
+				},
+			},
+
 			"enable_http2": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -967,7 +1036,7 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Application Gateway %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Application Gateway %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -996,7 +1065,10 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	redirectConfigurations := expandApplicationGatewayRedirectConfigurations(d, gatewayID)
 	sku := expandApplicationGatewaySku(d)
 	sslCertificates := expandApplicationGatewaySslCertificates(d)
-	sslPolicy := expandApplicationGatewaySslPolicy(d)
+	sslPolicy, err := expandApplicationGatewaySslPolicy(d)
+	if err != nil {
+		return fmt.Errorf("Error expanding `ssl_policy`: %+v", err)
+	}
 	customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(d.Get("custom_error_configuration").([]interface{}))
 	urlPathMaps := expandApplicationGatewayURLPathMaps(d, gatewayID)
 
@@ -1056,16 +1128,16 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, gateway)
 	if err != nil {
-		return fmt.Errorf("Error Creating/Updating Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error Creating/Updating Application Gateway %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the create/update of Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error waiting for the create/update of Application Gateway %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 	if read.ID == nil {
 		return fmt.Errorf("Cannot read ID of Application Gateway %q (Resource Group %q)", name, resGroup)
@@ -1095,7 +1167,7 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Application Gateway %s: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Application Gateway %s: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", applicationGateway.Name)
@@ -1126,6 +1198,10 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `disabled_ssl_protocols`: %+v", setErr)
 		}
 
+		if setErr := d.Set("ssl_policy", flattenApplicationGatewaySslPolicy(props.SslPolicy)); setErr != nil {
+			return fmt.Errorf("Error setting `ssl_policy`: %+v", setErr)
+		}
+
 		d.Set("enable_http2", props.EnableHTTP2)
 
 		httpListeners, err := flattenApplicationGatewayHTTPListeners(props.HTTPListeners)
@@ -1211,11 +1287,11 @@ func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting for Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error deleting for Application Gateway %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for deletion of Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error waiting for deletion of Application Gateway %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	return nil
@@ -1253,23 +1329,29 @@ func flattenApplicationGatewayAuthenticationCertificates(input *[]network.Applic
 		return results
 	}
 
-	for i, v := range *input {
+	for _, v := range *input {
 		output := map[string]interface{}{}
 
 		if v.ID != nil {
 			output["id"] = *v.ID
 		}
 
+		name := ""
 		if v.Name != nil {
-			output["name"] = *v.Name
+			name = *v.Name
 		}
+		output["name"] = name
 
-		// since the certificate data isn't returned we have to load it from the same index
+		// since the certificate data isn't returned we have to load it from the certificate with the same name,
+		// rather than the same index - otherwise removing a certificate outside of Terraform shifts the data
 		if existing, ok := d.GetOk("authentication_certificate"); ok && existing != nil {
-			existingVals := existing.([]interface{})
-			if len(existingVals) >= i {
-				existingCerts := existingVals[i].(map[string]interface{})
-				if data := existingCerts["data"]; data != nil {
+			for _, existingVal := range existing.([]interface{}) {
+				existingCert := existingVal.(map[string]interface{})
+				if existingCert["name"].(string) != name {
+					continue
+				}
+
+				if data := existingCert["data"]; data != nil {
 					output["data"] = data.(string)
 				}
 			}
@@ -1554,17 +1636,99 @@ func flattenApplicationGatewayConnectionDraining(input *network.ApplicationGatew
 	return []interface{}{result}
 }
 
-func expandApplicationGatewaySslPolicy(d *schema.ResourceData) *network.ApplicationGatewaySslPolicy {
-	vs := d.Get("disabled_ssl_protocols").([]interface{})
-	results := make([]network.ApplicationGatewaySslProtocol, 0)
+func expandApplicationGatewaySslPolicy(d *schema.ResourceData) (*network.ApplicationGatewaySslPolicy, error) {
+	policy := network.ApplicationGatewaySslPolicy{}
+	disabledProtocols := make([]network.ApplicationGatewaySslProtocol, 0)
+
+	// since `ssl_policy` is Computed it'll be populated from the state when the deprecated
+	// `disabled_ssl_protocols` field is used - so changes to that field take precedence
+	vs := d.Get("ssl_policy").([]interface{})
+	if len(vs) == 0 || vs[0] == nil || d.HasChange("disabled_ssl_protocols") {
+		for _, v := range d.Get("disabled_ssl_protocols").([]interface{}) {
+			disabledProtocols = append(disabledProtocols, network.ApplicationGatewaySslProtocol(v.(string)))
+		}
+
+		policy.DisabledSslProtocols = &disabledProtocols
+		return &policy, nil
+	}
 
-	for _, v := range vs {
-		results = append(results, network.ApplicationGatewaySslProtocol(v.(string)))
+	v := vs[0].(map[string]interface{})
+	for _, protocol := range v["disabled_protocols"].([]interface{}) {
+		disabledProtocols = append(disabledProtocols, network.ApplicationGatewaySslProtocol(protocol.(string)))
 	}
+	policy.DisabledSslProtocols = &disabledProtocols
 
-	return &network.ApplicationGatewaySslPolicy{
-		DisabledSslProtocols: &results,
+	policyType := network.ApplicationGatewaySslPolicyType(v["policy_type"].(string))
+	policyName := v["policy_name"].(string)
+	minProtocolVersion := v["min_protocol_version"].(string)
+	cipherSuites := make([]network.ApplicationGatewaySslCipherSuite, 0)
+	for _, cipherSuite := range v["cipher_suites"].([]interface{}) {
+		cipherSuites = append(cipherSuites, network.ApplicationGatewaySslCipherSuite(cipherSuite.(string)))
 	}
+
+	switch policyType {
+	case network.Predefined:
+		if policyName == "" {
+			return nil, fmt.Errorf("`policy_name` must be specified when `policy_type` is `Predefined`")
+		}
+		if len(cipherSuites) > 0 || minProtocolVersion != "" {
+			return nil, fmt.Errorf("`cipher_suites` and `min_protocol_version` cannot be specified when `policy_type` is `Predefined`")
+		}
+
+		policy.PolicyName = network.ApplicationGatewaySslPolicyName(policyName)
+
+	case network.Custom:
+		if policyName != "" {
+			return nil, fmt.Errorf("`policy_name` cannot be specified when `policy_type` is `Custom`")
+		}
+		if len(cipherSuites) == 0 || minProtocolVersion == "" {
+			return nil, fmt.Errorf("`cipher_suites` and `min_protocol_version` must be specified when `policy_type` is `Custom`")
+		}
+
+		policy.CipherSuites = &cipherSuites
+		policy.MinProtocolVersion = network.ApplicationGatewaySslProtocol(minProtocolVersion)
+
+	default:
+		if policyName != "" || len(cipherSuites) > 0 || minProtocolVersion != "" {
+			return nil, fmt.Errorf("`policy_type` must be specified when using `policy_name`, `cipher_suites` or `min_protocol_version`")
+		}
+	}
+
+	policy.PolicyType = policyType
+	return &policy, nil
+}
+
+func flattenApplicationGatewaySslPolicy(input *network.ApplicationGatewaySslPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	cipherSuites := make([]interface{}, 0)
+	if input.CipherSuites != nil {
+		for _, v := range *input.CipherSuites {
+			cipherSuites = append(cipherSuites, string(v))
+		}
+	}
+
+	results = append(results, map[string]interface{}{
+		"disabled_protocols":   flattenApplicationGatewayDisabledSSLProtocols(input),
+		"policy_type":          string(input.PolicyType),
+		"policy_name":          string(input.PolicyName),
+		"cipher_suites":        cipherSuites,
+		"min_protocol_version": string(input.MinProtocolVersion),
+	})
+
+	return results
+}
+
+func validateApplicationGatewaySslCipherSuite(v interface{}, k string) (warnings []string, errors []error) {
+	suites := make([]string, 0)
+	for _, suite := range network.PossibleApplicationGatewaySslCipherSuiteValues() {
+		suites = append(suites, string(suite))
+	}
+
+	return validation.StringInSlice(suites, false)(v, k)
 }
 
 func flattenApplicationGatewayDisabledSSLProtocols(input *network.ApplicationGatewaySslPolicy) []interface{} {
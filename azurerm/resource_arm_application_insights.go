@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -81,7 +82,7 @@ func resourceArmApplicationInsightsCreateUpdate(d *schema.ResourceData, meta int
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Application Insights %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Application Insights %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -113,13 +114,13 @@ func resourceArmApplicationInsightsCreateUpdate(d *schema.ResourceData, meta int
 		// which doesn't match the Swagger - this works around it until that's fixed
 		// BUG: https://github.com/Azure/azure-sdk-for-go/issues/2465
 		if resp.StatusCode != http.StatusCreated {
-			return fmt.Errorf("Error creating Application Insights %q (Resource Group %q): %+v", name, resGroup, err)
+			return fmt.Errorf("Error creating Application Insights %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Application Insights %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Application Insights %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 	if read.ID == nil {
 		return fmt.Errorf("Cannot read AzureRM Application Insights '%s' (Resource Group %s) ID", name, resGroup)
@@ -150,7 +151,7 @@ func resourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Application Insights '%s': %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Application Insights '%s': %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", name)
@@ -188,7 +189,7 @@ func resourceArmApplicationInsightsDelete(d *schema.ResourceData, meta interface
 		if resp.StatusCode == http.StatusNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error issuing AzureRM delete request for Application Insights '%s': %+v", name, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Application Insights '%s': %s", name, azure.FormatError(err))
 	}
 
 	return err
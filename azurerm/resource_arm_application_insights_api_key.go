@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -31,10 +32,11 @@ func resourceArmApplicationInsightsAPIKey() *schema.Resource {
 			},
 
 			"application_insights_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"read_permissions": {
@@ -90,7 +92,7 @@ func resourceArmApplicationInsightsAPIKeyCreate(d *schema.ResourceData, meta int
 		existing, err = client.Get(ctx, resGroup, appInsightsName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Application Insights API key %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Application Insights API key %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -107,7 +109,7 @@ func resourceArmApplicationInsightsAPIKeyCreate(d *schema.ResourceData, meta int
 
 	result, err := client.Create(ctx, resGroup, appInsightsName, apiKeyProperties)
 	if err != nil {
-		return fmt.Errorf("Error creating Application Insights API key %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error creating Application Insights API key %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if result.APIKey == nil {
@@ -144,7 +146,7 @@ func resourceArmApplicationInsightsAPIKeyRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Application Insights API key '%s': %+v", keyID, err)
+		return fmt.Errorf("Error making Read request on AzureRM Application Insights API key '%s': %s", keyID, azure.FormatError(err))
 	}
 
 	d.Set("application_insights_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/components/%s", client.SubscriptionID, resGroup, appInsightsName))
@@ -181,7 +183,7 @@ func resourceArmApplicationInsightsAPIKeyDelete(d *schema.ResourceData, meta int
 		if utils.ResponseWasNotFound(result.Response) {
 			return nil
 		}
-		return fmt.Errorf("Error issuing AzureRM delete request for Application Insights API key '%s': %+v", keyID, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Application Insights API key '%s': %s", keyID, azure.FormatError(err))
 	}
 
 	return nil
@@ -0,0 +1,196 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationInsightsSmartDetectionRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationInsightsSmartDetectionRuleUpdate,
+		Read:   resourceArmApplicationInsightsSmartDetectionRuleRead,
+		Update: resourceArmApplicationInsightsSmartDetectionRuleUpdate,
+		Delete: resourceArmApplicationInsightsSmartDetectionRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"slowpageloadtime",
+					"slowserverresponsetime",
+					"longdependencyduration",
+					"degradationinserverresponsetime",
+					"degradationindependencyduration",
+					"extension_traceseveritydetector",
+					"extension_exceptionchangeextension",
+					"extension_memoryleakextension",
+					"extension_securityextensionspackage",
+					"extension_billingdatavolumedailyspikeextension",
+				}, false),
+			},
+
+			"application_insights_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"send_default_emails_to_subscription_owners": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"additional_email_recipients": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmApplicationInsightsSmartDetectionRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsSmartDetectionRuleClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	appInsightsID := d.Get("application_insights_id").(string)
+
+	id, err := parseAzureResourceID(appInsightsID)
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+
+	// Smart Detection Rules always exist for an Application Insights component and can't be
+	// created or deleted - so we retrieve the existing rule (which includes the static
+	// rule definition that must be sent back) and update its configurable fields
+	existing, err := client.Get(ctx, resGroup, appInsightsName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Smart Detection Rule %q (Application Insights %q / Resource Group %q): %s", name, appInsightsName, resGroup, azure.FormatError(err))
+	}
+
+	emails := make([]string, 0)
+	for _, v := range d.Get("additional_email_recipients").(*schema.Set).List() {
+		emails = append(emails, v.(string))
+	}
+
+	existing.Enabled = utils.Bool(d.Get("enabled").(bool))
+	existing.SendEmailsToSubscriptionOwners = utils.Bool(d.Get("send_default_emails_to_subscription_owners").(bool))
+	existing.CustomEmails = &emails
+
+	log.Printf("[DEBUG] Updating Smart Detection Rule %q (Application Insights %q / Resource Group %q)", name, appInsightsName, resGroup)
+	if _, err := client.Update(ctx, resGroup, appInsightsName, name, existing); err != nil {
+		return fmt.Errorf("Error updating Smart Detection Rule %q (Application Insights %q / Resource Group %q): %s", name, appInsightsName, resGroup, azure.FormatError(err))
+	}
+
+	// the API doesn't return an ID for a Smart Detection Rule, so we build one up
+	d.SetId(fmt.Sprintf("%s/ProactiveDetectionConfigs/%s", appInsightsID, name))
+
+	return resourceArmApplicationInsightsSmartDetectionRuleRead(d, meta)
+}
+
+func resourceArmApplicationInsightsSmartDetectionRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsSmartDetectionRuleClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+	name := id.Path["ProactiveDetectionConfigs"]
+
+	resp, err := client.Get(ctx, resGroup, appInsightsName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Smart Detection Rule %q was not found for Application Insights %q (Resource Group %q) - removing from state!", name, appInsightsName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Smart Detection Rule %q (Application Insights %q / Resource Group %q): %s", name, appInsightsName, resGroup, azure.FormatError(err))
+	}
+
+	d.Set("name", name)
+	d.Set("application_insights_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/components/%s", client.SubscriptionID, resGroup, appInsightsName))
+	d.Set("enabled", resp.Enabled)
+	d.Set("send_default_emails_to_subscription_owners", resp.SendEmailsToSubscriptionOwners)
+
+	emails := make([]interface{}, 0)
+	if resp.CustomEmails != nil {
+		for _, v := range *resp.CustomEmails {
+			emails = append(emails, v)
+		}
+	}
+	if err := d.Set("additional_email_recipients", schema.NewSet(schema.HashString, emails)); err != nil {
+		return fmt.Errorf("Error setting `additional_email_recipients`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApplicationInsightsSmartDetectionRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsSmartDetectionRuleClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+	name := id.Path["ProactiveDetectionConfigs"]
+
+	existing, err := client.Get(ctx, resGroup, appInsightsName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Smart Detection Rule %q (Application Insights %q / Resource Group %q): %s", name, appInsightsName, resGroup, azure.FormatError(err))
+	}
+
+	// since a Smart Detection Rule can't be deleted, we reset it to the defaults instead
+	enabledByDefault := true
+	if definitions := existing.RuleDefinitions; definitions != nil && definitions.IsEnabledByDefault != nil {
+		enabledByDefault = *definitions.IsEnabledByDefault
+	}
+	existing.Enabled = utils.Bool(enabledByDefault)
+	existing.SendEmailsToSubscriptionOwners = utils.Bool(true)
+	existing.CustomEmails = &[]string{}
+
+	log.Printf("[DEBUG] Resetting Smart Detection Rule %q (Application Insights %q / Resource Group %q)", name, appInsightsName, resGroup)
+	if _, err := client.Update(ctx, resGroup, appInsightsName, name, existing); err != nil {
+		return fmt.Errorf("Error resetting Smart Detection Rule %q (Application Insights %q / Resource Group %q): %s", name, appInsightsName, resGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMApplicationInsightsSmartDetectionRule_basic(t *testing.T) {
+	resourceName := "azurerm_application_insights_smart_detection_rule.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationInsightsSmartDetectionRule_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsSmartDetectionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsightsSmartDetectionRule_update(t *testing.T) {
+	resourceName := "azurerm_application_insights_smart_detection_rule.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationInsightsSmartDetectionRule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsSmartDetectionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationInsightsSmartDetectionRule_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsSmartDetectionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "send_default_emails_to_subscription_owners", "false"),
+					resource.TestCheckResourceAttr(resourceName, "additional_email_recipients.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationInsightsSmartDetectionRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).appInsightsSmartDetectionRuleClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resGroup := id.ResourceGroup
+		appInsightsName := id.Path["components"]
+		name := id.Path["ProactiveDetectionConfigs"]
+
+		resp, err := client.Get(ctx, resGroup, appInsightsName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on appInsightsSmartDetectionRuleClient: %+v", err)
+		}
+
+		if resp.Name == nil {
+			return fmt.Errorf("Bad: Smart Detection Rule %q (Application Insights %q / Resource Group %q) does not exist", name, appInsightsName, resGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApplicationInsightsSmartDetectionRule_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMApplicationInsightsSmartDetectionRule_basic(rInt int, location string) string {
+	template := testAccAzureRMApplicationInsightsSmartDetectionRule_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_smart_detection_rule" "test" {
+  name                    = "slowpageloadtime"
+  application_insights_id = "${azurerm_application_insights.test.id}"
+  enabled                 = false
+}
+`, template)
+}
+
+func testAccAzureRMApplicationInsightsSmartDetectionRule_complete(rInt int, location string) string {
+	template := testAccAzureRMApplicationInsightsSmartDetectionRule_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_smart_detection_rule" "test" {
+  name                    = "slowpageloadtime"
+  application_insights_id = "${azurerm_application_insights.test.id}"
+  enabled                 = true
+
+  send_default_emails_to_subscription_owners = false
+  additional_email_recipients                = ["alerts@example.com", "oncall@example.com"]
+}
+`, template)
+}
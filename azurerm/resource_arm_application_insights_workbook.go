@@ -0,0 +1,195 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationInsightsWorkbook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationInsightsWorkbookCreateUpdate,
+		Read:   resourceArmApplicationInsightsWorkbookRead,
+		Update: resourceArmApplicationInsightsWorkbookCreateUpdate,
+		Delete: resourceArmApplicationInsightsWorkbookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the name of a Workbook must be a UUID
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"serialized_data": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "workbook",
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"source_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "azure monitor",
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.NoEmptyStrings,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmApplicationInsightsWorkbookCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWorkbooksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM Application Insights Workbook creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Application Insights Workbook %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_application_insights_workbook", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	workbook := insights.Workbook{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Kind:     insights.SharedTypeKindShared,
+		WorkbookProperties: &insights.WorkbookProperties{
+			Name:             utils.String(d.Get("display_name").(string)),
+			SerializedData:   utils.String(d.Get("serialized_data").(string)),
+			Category:         utils.String(d.Get("category").(string)),
+			SourceResourceID: utils.String(d.Get("source_id").(string)),
+			SharedTypeKind:   insights.SharedTypeKindShared,
+			Version:          utils.String("1.0"),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resGroup, name, workbook); err != nil {
+		return fmt.Errorf("Error creating Application Insights Workbook %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	read, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Insights Workbook %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read AzureRM Application Insights Workbook %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApplicationInsightsWorkbookRead(d, meta)
+}
+
+func resourceArmApplicationInsightsWorkbookRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWorkbooksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["workbooks"]
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Application Insights Workbook %q was not found in Resource Group %q - removing from state!", name, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on AzureRM Application Insights Workbook %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.WorkbookProperties; props != nil {
+		d.Set("display_name", props.Name)
+		d.Set("serialized_data", props.SerializedData)
+		d.Set("category", props.Category)
+		d.Set("source_id", props.SourceResourceID)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmApplicationInsightsWorkbookDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWorkbooksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["workbooks"]
+
+	log.Printf("[DEBUG] Deleting AzureRM Application Insights Workbook %q (Resource Group %q)", name, resGroup)
+
+	resp, err := client.Delete(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Application Insights Workbook %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
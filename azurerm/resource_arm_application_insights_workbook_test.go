@@ -0,0 +1,253 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMApplicationInsightsWorkbook_basic(t *testing.T) {
+	resourceName := "azurerm_application_insights_workbook.test"
+	ri := tf.AccRandTimeInt()
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsWorkbookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationInsightsWorkbook_basic(ri, name, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsWorkbookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "category", "workbook"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsightsWorkbook_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_application_insights_workbook.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsWorkbookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationInsightsWorkbook_basic(ri, name, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsWorkbookExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMApplicationInsightsWorkbook_requiresImport(ri, name, location),
+				ExpectError: testRequiresImportError("azurerm_application_insights_workbook"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsightsWorkbook_complete(t *testing.T) {
+	resourceName := "azurerm_application_insights_workbook.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsWorkbookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationInsightsWorkbook_basic(ri, name, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsWorkbookExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationInsightsWorkbook_complete(ri, name, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsWorkbookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "acctest-updated"),
+					resource.TestCheckResourceAttr(resourceName, "category", "tsg"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Testing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationInsightsWorkbookDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appInsightsWorkbooksClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_application_insights_workbook" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Application Insights Workbook still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMApplicationInsightsWorkbookExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).appInsightsWorkbooksClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Application Insights Workbook: %s", name)
+		}
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Application Insights Workbook %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appInsightsWorkbooksClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApplicationInsightsWorkbook_basic(rInt int, name string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights_workbook" "test" {
+  name                = "%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  display_name        = "acctest-%d"
+
+  serialized_data = <<DATA
+{
+  "version": "Notebook/1.0",
+  "items": [
+    {
+      "type": 1,
+      "content": {
+        "json": "Test1"
+      },
+      "name": "text - 0"
+    }
+  ],
+  "isLocked": false
+}
+DATA
+}
+`, rInt, location, name, rInt)
+}
+
+func testAccAzureRMApplicationInsightsWorkbook_requiresImport(rInt int, name string, location string) string {
+	template := testAccAzureRMApplicationInsightsWorkbook_basic(rInt, name, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_workbook" "import" {
+  name                = "${azurerm_application_insights_workbook.test.name}"
+  resource_group_name = "${azurerm_application_insights_workbook.test.resource_group_name}"
+  location            = "${azurerm_application_insights_workbook.test.location}"
+  display_name        = "${azurerm_application_insights_workbook.test.display_name}"
+  serialized_data     = "${azurerm_application_insights_workbook.test.serialized_data}"
+}
+`, template)
+}
+
+func testAccAzureRMApplicationInsightsWorkbook_complete(rInt int, name string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights_workbook" "test" {
+  name                = "%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  display_name        = "acctest-updated"
+  category            = "tsg"
+
+  serialized_data = <<DATA
+{
+  "version": "Notebook/1.0",
+  "items": [
+    {
+      "type": 1,
+      "content": {
+        "json": "Test2"
+      },
+      "name": "text - 0"
+    }
+  ],
+  "isLocked": false
+}
+DATA
+
+  tags = {
+    environment = "Testing"
+  }
+}
+`, rInt, location, name)
+}
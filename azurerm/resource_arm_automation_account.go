@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -90,7 +91,7 @@ func resourceArmAutomationAccountCreateUpdate(d *schema.ResourceData, meta inter
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Automation Account %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Automation Account %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -112,12 +113,12 @@ func resourceArmAutomationAccountCreateUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, name, parameters); err != nil {
-		return fmt.Errorf("Error creating/updating Automation Account %q (Resource Group %q) %+v", name, resGroup, err)
+		return fmt.Errorf("Error creating/updating Automation Account %q (Resource Group %q) %s", name, resGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Automation Account %q (Resource Group %q) %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Automation Account %q (Resource Group %q) %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -149,7 +150,7 @@ func resourceArmAutomationAccountRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Automation Account %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error making Read request on Automation Account %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	keysResp, err := registrationClient.Get(ctx, resGroup, name)
@@ -160,7 +161,7 @@ func resourceArmAutomationAccountRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request for Agent Registration Info for Automation Account %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error making Read request for Agent Registration Info for Automation Account %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -204,7 +205,7 @@ func resourceArmAutomationAccountDelete(d *schema.ResourceData, meta interface{}
 			return nil
 		}
 
-		return fmt.Errorf("Error issuing AzureRM delete request for Automation Account '%s': %+v", name, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Automation Account '%s': %s", name, azure.FormatError(err))
 	}
 
 	return nil
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -69,7 +70,7 @@ func resourceArmAutomationCredentialCreateUpdate(d *schema.ResourceData, meta in
 		existing, err := client.Get(ctx, resGroup, accName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Automation Credential %q (Account %q / Resource Group %q): %s", name, accName, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Automation Credential %q (Account %q / Resource Group %q): %s", name, accName, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -128,7 +129,7 @@ func resourceArmAutomationCredentialRead(d *schema.ResourceData, meta interface{
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on AzureRM Automation Credential '%s': %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Automation Credential '%s': %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -160,7 +161,7 @@ func resourceArmAutomationCredentialDelete(d *schema.ResourceData, meta interfac
 			return nil
 		}
 
-		return fmt.Errorf("Error issuing AzureRM delete request for Automation Credential '%s': %+v", name, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Automation Credential '%s': %s", name, azure.FormatError(err))
 	}
 
 	return nil
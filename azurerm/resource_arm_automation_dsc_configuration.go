@@ -9,6 +9,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -86,7 +87,7 @@ func resourceArmAutomationDscConfigurationCreateUpdate(d *schema.ResourceData, m
 		existing, err := client.Get(ctx, resGroup, accName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Automation DSC Configuration %q (Account %q / Resource Group %q): %s", name, accName, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Automation DSC Configuration %q (Account %q / Resource Group %q): %s", name, accName, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -149,7 +150,7 @@ func resourceArmAutomationDscConfigurationRead(d *schema.ResourceData, meta inte
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on AzureRM Automation Dsc Configuration %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Automation Dsc Configuration %q: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -168,7 +169,7 @@ func resourceArmAutomationDscConfigurationRead(d *schema.ResourceData, meta inte
 
 	contentresp, err := client.GetContent(ctx, resGroup, accName, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM Automation Dsc Configuration content %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Automation Dsc Configuration content %q: %s", name, azure.FormatError(err))
 	}
 
 	buf := new(bytes.Buffer)
@@ -200,7 +201,7 @@ func resourceArmAutomationDscConfigurationDelete(d *schema.ResourceData, meta in
 			return nil
 		}
 
-		return fmt.Errorf("Error issuing AzureRM delete request for Automation Dsc Configuration %q: %+v", name, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Automation Dsc Configuration %q: %s", name, azure.FormatError(err))
 	}
 
 	return nil
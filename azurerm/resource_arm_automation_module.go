@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -88,7 +89,7 @@ func resourceArmAutomationModuleCreateUpdate(d *schema.ResourceData, meta interf
 		existing, err := client.Get(ctx, resGroup, accName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Automation Module %q (Account %q / Resource Group %q): %s", name, accName, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Automation Module %q (Account %q / Resource Group %q): %s", name, accName, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -142,7 +143,7 @@ func resourceArmAutomationModuleRead(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on AzureRM Automation Module %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Automation Module %q: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -170,7 +171,7 @@ func resourceArmAutomationModuleDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error issuing AzureRM delete request for Automation Module %q: %+v", name, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Automation Module %q: %s", name, azure.FormatError(err))
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -133,7 +134,7 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 		existing, err := client.Get(ctx, resGroup, accName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Automation Runbook %q (Account %q / Resource Group %q): %s", name, accName, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Automation Runbook %q (Account %q / Resource Group %q): %s", name, accName, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -166,7 +167,7 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, accName, name, parameters); err != nil {
-		return fmt.Errorf("Error creating/updating Automation Runbook %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+		return fmt.Errorf("Error creating/updating Automation Runbook %q (Account %q / Resource Group %q): %s", name, accName, resGroup, azure.FormatError(err))
 	}
 
 	if v, ok := d.GetOk("content"); ok {
@@ -175,17 +176,17 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 		draftClient := meta.(*ArmClient).automationRunbookDraftClient
 
 		if _, err := draftClient.ReplaceContent(ctx, resGroup, accName, name, reader); err != nil {
-			return fmt.Errorf("Error setting the draft Automation Runbook %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+			return fmt.Errorf("Error setting the draft Automation Runbook %q (Account %q / Resource Group %q): %s", name, accName, resGroup, azure.FormatError(err))
 		}
 
 		if _, err := draftClient.Publish(ctx, resGroup, accName, name); err != nil {
-			return fmt.Errorf("Error publishing the updated Automation Runbook %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+			return fmt.Errorf("Error publishing the updated Automation Runbook %q (Account %q / Resource Group %q): %s", name, accName, resGroup, azure.FormatError(err))
 		}
 	}
 
 	read, err := client.Get(ctx, resGroup, accName, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Automation Runbook %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+		return fmt.Errorf("Error retrieving Automation Runbook %q (Account %q / Resource Group %q): %s", name, accName, resGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -216,7 +217,7 @@ func resourceArmAutomationRunbookRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on AzureRM Automation Runbook %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+		return fmt.Errorf("Error making Read request on AzureRM Automation Runbook %q (Account %q / Resource Group %q): %s", name, accName, resGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -239,7 +240,7 @@ func resourceArmAutomationRunbookRead(d *schema.ResourceData, meta interface{})
 
 	response, err := client.GetContent(ctx, resGroup, accName, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving content for Automation Runbook %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+		return fmt.Errorf("Error retrieving content for Automation Runbook %q (Account %q / Resource Group %q): %s", name, accName, resGroup, azure.FormatError(err))
 	}
 
 	if v := response.Value; v != nil {
@@ -274,7 +275,7 @@ func resourceArmAutomationRunbookDelete(d *schema.ResourceData, meta interface{}
 			return nil
 		}
 
-		return fmt.Errorf("Error issuing AzureRM delete request for Automation Runbook '%s': %+v", name, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Automation Runbook '%s': %s", name, azure.FormatError(err))
 	}
 
 	return nil
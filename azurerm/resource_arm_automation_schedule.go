@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -236,7 +237,7 @@ func resourceArmAutomationScheduleCreateUpdate(d *schema.ResourceData, meta inte
 		existing, err := client.Get(ctx, resGroup, accountName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Automation Schedule %q (Account %q / Resource Group %q): %s", name, accountName, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Automation Schedule %q (Account %q / Resource Group %q): %s", name, accountName, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -328,7 +329,7 @@ func resourceArmAutomationScheduleRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on AzureRM Automation Schedule '%s': %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Automation Schedule '%s': %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -384,7 +385,7 @@ func resourceArmAutomationScheduleDelete(d *schema.ResourceData, meta interface{
 	resp, err := client.Delete(ctx, resGroup, accountName, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error issuing AzureRM delete request for Automation Schedule '%s': %+v", name, err)
+			return fmt.Errorf("Error issuing AzureRM delete request for Automation Schedule '%s': %s", name, azure.FormatError(err))
 		}
 	}
 
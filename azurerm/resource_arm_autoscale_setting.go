@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -47,10 +48,11 @@ As such the existing 'azurerm_autoscale_setting' resource is deprecated and will
 			"location": locationSchema(),
 
 			"target_resource_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"enabled": {
@@ -112,9 +114,10 @@ As such the existing 'azurerm_autoscale_setting' resource is deprecated and will
 													ValidateFunc: validate.NoEmptyStrings,
 												},
 												"metric_resource_id": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: azure.ValidateResourceID,
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateFunc:     azure.ValidateResourceID,
+													DiffSuppressFunc: suppress.ResourceIDDifference,
 												},
 												"time_grain": {
 													Type:         schema.TypeString,
@@ -358,7 +361,7 @@ func resourceArmAutoScaleSettingCreateUpdate(d *schema.ResourceData, meta interf
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing AutoScale Setting %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing AutoScale Setting %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -395,12 +398,12 @@ func resourceArmAutoScaleSettingCreateUpdate(d *schema.ResourceData, meta interf
 	}
 
 	if _, err = client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
-		return fmt.Errorf("Error creating AutoScale Setting %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating AutoScale Setting %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving AutoScale Setting %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving AutoScale Setting %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 	if read.ID == nil {
 		return fmt.Errorf("AutoScale Setting %q (Resource Group %q) has no ID", name, resourceGroup)
@@ -430,7 +433,7 @@ func resourceArmAutoScaleSettingRead(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error reading AutoScale Setting %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error reading AutoScale Setting %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", name)
@@ -476,7 +479,7 @@ func resourceArmAutoScaleSettingDelete(d *schema.ResourceData, meta interface{})
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("Error deleting AutoScale Setting %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error deleting AutoScale Setting %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
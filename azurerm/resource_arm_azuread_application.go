@@ -8,6 +8,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -145,7 +146,7 @@ func resourceArmActiveDirectoryApplicationUpdate(d *schema.ResourceData, meta in
 	}
 
 	if _, err := client.Patch(ctx, d.Id(), properties); err != nil {
-		return fmt.Errorf("Error patching Azure AD Application with ID %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error patching Azure AD Application with ID %q: %s", d.Id(), azure.FormatError(err))
 	}
 
 	return resourceArmActiveDirectoryApplicationRead(d, meta)
@@ -163,7 +164,7 @@ func resourceArmActiveDirectoryApplicationRead(d *schema.ResourceData, meta inte
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Azure AD Application with ID %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error retrieving Azure AD Application with ID %q: %s", d.Id(), azure.FormatError(err))
 	}
 
 	d.Set("name", resp.DisplayName)
@@ -204,14 +205,14 @@ func resourceArmActiveDirectoryApplicationDelete(d *schema.ResourceData, meta in
 		}
 
 		if _, err := client.Patch(ctx, d.Id(), properties); err != nil {
-			return fmt.Errorf("Error patching Azure AD Application with ID %q: %+v", d.Id(), err)
+			return fmt.Errorf("Error patching Azure AD Application with ID %q: %s", d.Id(), azure.FormatError(err))
 		}
 	}
 
 	resp, err := client.Delete(ctx, d.Id())
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error Deleting Azure AD Application with ID %q: %+v", d.Id(), err)
+			return fmt.Errorf("Error Deleting Azure AD Application with ID %q: %s", d.Id(), azure.FormatError(err))
 		}
 	}
 
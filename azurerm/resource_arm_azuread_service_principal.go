@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -50,7 +51,7 @@ func resourceArmActiveDirectoryServicePrincipalCreate(d *schema.ResourceData, me
 
 	apps, err := client.ListComplete(ctx, "")
 	if err != nil {
-		return fmt.Errorf("Error checking for existence of Service Principal %q: %+v", applicationId, err)
+		return fmt.Errorf("Error checking for existence of Service Principal %q: %s", applicationId, azure.FormatError(err))
 	}
 
 	for apps.NotDone() {
@@ -78,13 +79,13 @@ func resourceArmActiveDirectoryServicePrincipalCreate(d *schema.ResourceData, me
 
 	app, err := client.Create(ctx, properties)
 	if err != nil {
-		return fmt.Errorf("Error creating Service Principal %q: %+v", applicationId, err)
+		return fmt.Errorf("Error creating Service Principal %q: %s", applicationId, azure.FormatError(err))
 	}
 
 	objectId := *app.ObjectID
 	resp, err := client.Get(ctx, objectId)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %s", objectId, azure.FormatError(err))
 	}
 
 	d.SetId(*resp.ObjectID)
@@ -104,7 +105,7 @@ func resourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %s", objectId, azure.FormatError(err))
 	}
 
 	d.Set("application_id", app.AppID)
@@ -121,7 +122,7 @@ func resourceArmActiveDirectoryServicePrincipalDelete(d *schema.ResourceData, me
 	app, err := client.Delete(ctx, applicationId)
 	if err != nil {
 		if !response.WasNotFound(app.Response) {
-			return fmt.Errorf("Error deleting Service Principal ID %q: %+v", applicationId, err)
+			return fmt.Errorf("Error deleting Service Principal ID %q: %s", applicationId, azure.FormatError(err))
 		}
 	}
 
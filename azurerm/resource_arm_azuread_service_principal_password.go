@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -109,7 +110,7 @@ func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.Resource
 
 	existingCredentials, err := client.ListPasswordCredentials(ctx, objectId)
 	if err != nil {
-		return fmt.Errorf("Error Listing Password Credentials for Service Principal %q: %+v", objectId, err)
+		return fmt.Errorf("Error Listing Password Credentials for Service Principal %q: %s", objectId, azure.FormatError(err))
 	}
 
 	updatedCredentials := make([]graphrbac.PasswordCredential, 0)
@@ -134,7 +135,7 @@ func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.Resource
 	}
 	_, err = client.UpdatePasswordCredentials(ctx, objectId, parameters)
 	if err != nil {
-		return fmt.Errorf("Error creating Password Credential %q for Service Principal %q: %+v", keyId, objectId, err)
+		return fmt.Errorf("Error creating Password Credential %q for Service Principal %q: %s", keyId, objectId, azure.FormatError(err))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", objectId, keyId))
@@ -163,12 +164,12 @@ func resourceArmActiveDirectoryServicePrincipalPasswordRead(d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %s", objectId, azure.FormatError(err))
 	}
 
 	credentials, err := client.ListPasswordCredentials(ctx, objectId)
 	if err != nil {
-		return fmt.Errorf("Error Listing Password Credentials for Service Principal with Object ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error Listing Password Credentials for Service Principal with Object ID %q: %s", objectId, azure.FormatError(err))
 	}
 
 	var credential *graphrbac.PasswordCredential
@@ -227,12 +228,12 @@ func resourceArmActiveDirectoryServicePrincipalPasswordDelete(d *schema.Resource
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %s", objectId, azure.FormatError(err))
 	}
 
 	existing, err := client.ListPasswordCredentials(ctx, objectId)
 	if err != nil {
-		return fmt.Errorf("Error Listing Password Credentials for Service Principal with Object ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error Listing Password Credentials for Service Principal with Object ID %q: %s", objectId, azure.FormatError(err))
 	}
 
 	updatedCredentials := make([]graphrbac.PasswordCredential, 0)
@@ -251,7 +252,7 @@ func resourceArmActiveDirectoryServicePrincipalPasswordDelete(d *schema.Resource
 	}
 	_, err = client.UpdatePasswordCredentials(ctx, objectId, parameters)
 	if err != nil {
-		return fmt.Errorf("Error removing Password %q from Service Principal %q: %+v", keyId, objectId, err)
+		return fmt.Errorf("Error removing Password %q from Service Principal %q: %s", keyId, objectId, azure.FormatError(err))
 	}
 
 	return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -34,10 +35,11 @@ func resourceArmBatchAccount() *schema.Resource {
 			"resource_group_name": resourceGroupNameSchema(),
 			"location":            locationSchema(),
 			"storage_account_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"pool_allocation_mode": {
 				Type:     schema.TypeString,
@@ -84,7 +86,7 @@ func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) err
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Batch Account %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Batch Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -109,16 +111,16 @@ func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) err
 
 	future, err := client.Create(ctx, resourceGroup, name, parameters)
 	if err != nil {
-		return fmt.Errorf("Error creating Batch account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating Batch account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of Batch account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for creation of Batch account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Batch account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Batch account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -148,7 +150,7 @@ func resourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Batch Account %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
 			return nil
 		}
-		return fmt.Errorf("Error reading the state of Batch account %q: %+v", name, err)
+		return fmt.Errorf("Error reading the state of Batch account %q: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -170,7 +172,7 @@ func resourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error
 		keys, err := client.GetKeys(ctx, resourceGroup, name)
 
 		if err != nil {
-			return fmt.Errorf("Cannot read keys for Batch account %q (resource group %q): %v", name, resourceGroup, err)
+			return fmt.Errorf("Cannot read keys for Batch account %q (resource group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 
 		d.Set("primary_access_key", keys.Primary)
@@ -208,12 +210,12 @@ func resourceArmBatchAccountUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if _, err = client.Update(ctx, resourceGroup, name, parameters); err != nil {
-		return fmt.Errorf("Error updating Batch account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error updating Batch account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Batch account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Batch account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -238,12 +240,12 @@ func resourceArmBatchAccountDelete(d *schema.ResourceData, meta interface{}) err
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting Batch account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error deleting Batch account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("Error waiting for deletion of Batch account %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error waiting for deletion of Batch account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
@@ -117,9 +117,10 @@ func resourceArmBatchPool() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"publisher": {
@@ -256,7 +257,7 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 		existing, err := client.Get(ctx, resourceGroup, accountName, poolName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Batch Pool %q (Account %q / Resource Group %q): %+v", poolName, accountName, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Batch Pool %q (Account %q / Resource Group %q): %s", poolName, accountName, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -314,16 +315,16 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 
 	future, err := client.Create(ctx, resourceGroup, accountName, poolName, parameters, "", "")
 	if err != nil {
-		return fmt.Errorf("Error creating Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error creating Batch pool %q (Resource Group %q): %s", poolName, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error waiting for creation of Batch pool %q (Resource Group %q): %s", poolName, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Batch pool %q (Resource Group %q): %s", poolName, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -356,7 +357,7 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving the Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving the Batch pool %q (Resource Group %q): %s", poolName, resourceGroup, azure.FormatError(err))
 	}
 
 	if resp.PoolProperties.AllocationState != batch.Steady {
@@ -368,7 +369,7 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 
 		log.Printf("[INFO] stopping the pending resize operation on this pool...")
 		if _, err = client.StopResize(ctx, resourceGroup, accountName, poolName); err != nil {
-			return fmt.Errorf("Error stopping resize operation for Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+			return fmt.Errorf("Error stopping resize operation for Batch pool %q (Resource Group %q): %s", poolName, resourceGroup, azure.FormatError(err))
 		}
 
 		// waiting for the pool to be in steady state
@@ -407,7 +408,7 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 
 	result, err := client.Update(ctx, resourceGroup, accountName, poolName, parameters, "")
 	if err != nil {
-		return fmt.Errorf("Error updating Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error updating Batch pool %q (Resource Group %q): %s", poolName, resourceGroup, azure.FormatError(err))
 	}
 
 	// if the pool is not Steady after the update, wait for it to be Steady
@@ -437,7 +438,7 @@ func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Batch pool %q in account %q (Resource Group %q) was not found", poolName, accountName, resourceGroup)
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Batch pool %q: %+v", poolName, err)
+		return fmt.Errorf("Error making Read request on AzureRM Batch pool %q: %s", poolName, azure.FormatError(err))
 	}
 
 	d.Set("name", poolName)
@@ -486,12 +487,12 @@ func resourceArmBatchPoolDelete(d *schema.ResourceData, meta interface{}) error
 
 	future, err := client.Delete(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
-		return fmt.Errorf("Error deleting Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error deleting Batch pool %q (Resource Group %q): %s", poolName, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("Error waiting for deletion of Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+			return fmt.Errorf("Error waiting for deletion of Batch pool %q (Resource Group %q): %s", poolName, resourceGroup, azure.FormatError(err))
 		}
 	}
 	return nil
@@ -555,7 +556,7 @@ func waitForBatchPoolPendingResizeOperation(ctx context.Context, client batch.Po
 	for !isSteady {
 		resp, err := client.Get(ctx, resourceGroup, accountName, poolName)
 		if err != nil {
-			return fmt.Errorf("Error retrieving the Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+			return fmt.Errorf("Error retrieving the Batch pool %q (Resource Group %q): %s", poolName, resourceGroup, azure.FormatError(err))
 		}
 
 		isSteady = resp.PoolProperties.AllocationState == batch.Steady
@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2017-04-18/cognitiveservices"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -127,7 +128,7 @@ func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{})
 		existing, err := client.GetProperties(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Cognitive Account %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Cognitive Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -150,12 +151,12 @@ func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, properties); err != nil {
-		return fmt.Errorf("Error creating Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating Cognitive Services Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.GetProperties(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Cognitive Services Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.SetId(*read.ID)
@@ -185,7 +186,7 @@ func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{})
 
 	_, err = client.Update(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error updating Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error updating Cognitive Services Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	return resourceArmCognitiveAccountRead(d, meta)
@@ -238,7 +239,7 @@ func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error obtaining keys for Cognitive Services Account %q in Resource Group %q: %v", name, resourceGroup, err)
+		return fmt.Errorf("Error obtaining keys for Cognitive Services Account %q in Resource Group %q: %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("primary_access_key", keys.Key1)
@@ -265,7 +266,7 @@ func resourceArmCognitiveAccountDelete(d *schema.ResourceData, meta interface{})
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("Error deleting Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error deleting Cognitive Services Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
@@ -10,7 +10,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2017-10-01/containerregistry"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -71,8 +73,9 @@ func resourceArmContainerRegistry() *schema.Resource {
 			},
 
 			"storage_account_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"storage_account": {
@@ -140,7 +143,7 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Container Registry %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Container Registry %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -183,11 +186,11 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 
 	future, err := client.Create(ctx, resourceGroup, name, parameters)
 	if err != nil {
-		return fmt.Errorf("Error creating Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating Container Registry %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for creation of Container Registry %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	// locations have been specified for geo-replication
@@ -202,7 +205,7 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Container Registry %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -271,11 +274,11 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 
 	future, err := client.Update(ctx, resourceGroup, name, parameters)
 	if err != nil {
-		return fmt.Errorf("Error updating Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error updating Container Registry %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for update of Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for update of Container Registry %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if strings.EqualFold(sku, string(containerregistry.Premium)) && hasGeoReplicationChanges {
@@ -287,7 +290,7 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Container Registry %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -338,11 +341,11 @@ func applyGeoReplicationLocations(meta interface{}, resourceGroup string, name s
 
 		future, err := replicationClient.Create(ctx, resourceGroup, name, locationToCreate, replication)
 		if err != nil {
-			return fmt.Errorf("Error creating Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, locationToCreate, err)
+			return fmt.Errorf("Error creating Container Registry Replication %q (Resource Group %q, Location %q): %s", name, resourceGroup, locationToCreate, azure.FormatError(err))
 		}
 
 		if err = future.WaitForCompletionRef(ctx, replicationClient.Client); err != nil {
-			return fmt.Errorf("Error waiting for creation of Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, locationToCreate, err)
+			return fmt.Errorf("Error waiting for creation of Container Registry Replication %q (Resource Group %q, Location %q): %s", name, resourceGroup, locationToCreate, azure.FormatError(err))
 		}
 	}
 
@@ -357,11 +360,11 @@ func applyGeoReplicationLocations(meta interface{}, resourceGroup string, name s
 		// the old location is not in the list of locations, delete it
 		future, err := replicationClient.Delete(ctx, resourceGroup, name, oldLocation)
 		if err != nil {
-			return fmt.Errorf("Error deleting Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, oldLocation, err)
+			return fmt.Errorf("Error deleting Container Registry Replication %q (Resource Group %q, Location %q): %s", name, resourceGroup, oldLocation, azure.FormatError(err))
 		}
 
 		if err = future.WaitForCompletionRef(ctx, replicationClient.Client); err != nil {
-			return fmt.Errorf("Error waiting for deletion of Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, oldLocation, err)
+			return fmt.Errorf("Error waiting for deletion of Container Registry Replication %q (Resource Group %q, Location %q): %s", name, resourceGroup, oldLocation, azure.FormatError(err))
 		}
 	}
 
@@ -388,7 +391,7 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Azure Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Azure Container Registry %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -429,7 +432,7 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 
 	replications, err := replicationClient.List(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on Azure Container Registry %s for replications: %s", name, err)
+		return fmt.Errorf("Error making Read request on Azure Container Registry %s for replications: %s", name, azure.FormatError(err))
 	}
 
 	replicationValues := replications.Values()
@@ -469,14 +472,14 @@ func resourceArmContainerRegistryDelete(d *schema.ResourceData, meta interface{}
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("Error issuing Azure ARM delete request of Container Registry '%s': %+v", name, err)
+		return fmt.Errorf("Error issuing Azure ARM delete request of Container Registry '%s': %s", name, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("Error issuing Azure ARM delete request of Container Registry '%s': %+v", name, err)
+		return fmt.Errorf("Error issuing Azure ARM delete request of Container Registry '%s': %s", name, azure.FormatError(err))
 	}
 
 	return nil
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -205,7 +206,7 @@ func resourceArmContainerServiceCreateUpdate(d *schema.ResourceData, meta interf
 		existing, err := containerServiceClient.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Container Service %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Container Service %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -293,7 +294,7 @@ func resourceArmContainerServiceRead(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Azure Container Service %s: %s", name, err)
+		return fmt.Errorf("Error making Read request on Azure Container Service %s: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -343,7 +344,7 @@ func resourceArmContainerServiceDelete(d *schema.ResourceData, meta interface{})
 	future, err := containerServiceClient.Delete(ctx, resGroup, name)
 
 	if err != nil {
-		return fmt.Errorf("Error issuing Azure ARM delete request of Container Service '%s': %s", name, err)
+		return fmt.Errorf("Error issuing Azure ARM delete request of Container Service '%s': %s", name, azure.FormatError(err))
 	}
 
 	return future.WaitForCompletionRef(ctx, containerServiceClient.Client)
@@ -550,7 +551,7 @@ func containerServiceStateRefreshFunc(client *ArmClient, resourceGroupName strin
 		ctx := client.StopContext
 		res, err := client.containerServicesClient.Get(ctx, resourceGroupName, containerServiceName)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error issuing read request in containerServiceStateRefreshFunc to Azure ARM for Container Service '%s' (RG: '%s'): %s", containerServiceName, resourceGroupName, err)
+			return nil, "", fmt.Errorf("Error issuing read request in containerServiceStateRefreshFunc to Azure ARM for Container Service '%s' (RG: '%s'): %s", containerServiceName, resourceGroupName, azure.FormatError(err))
 		}
 
 		return res, *res.Properties.ProvisioningState, nil
@@ -19,6 +19,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -223,9 +224,10 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 					},
 				},
@@ -260,6 +262,10 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 				},
 			},
 
+			"read_locations": cosmosDBAccountRegionalEndpointsSchema(),
+
+			"write_locations": cosmosDBAccountRegionalEndpointsSchema(),
+
 			"primary_master_key": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -308,7 +314,7 @@ func resourceArmCosmosDBAccountCreate(d *schema.ResourceData, meta interface{})
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing CosmosDB Account %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing CosmosDB Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -328,7 +334,7 @@ func resourceArmCosmosDBAccountCreate(d *schema.ResourceData, meta interface{})
 
 	r, err := client.CheckNameExists(ctx, name)
 	if err != nil {
-		return fmt.Errorf("Error checking if CosmosDB Account %q already exists (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error checking if CosmosDB Account %q already exists (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 	if !utils.ResponseWasNotFound(r) {
 		return fmt.Errorf("CosmosDB Account %s already exists, please import the resource via terraform import", name)
@@ -381,11 +387,11 @@ func resourceArmCosmosDBAccountCreate(d *schema.ResourceData, meta interface{})
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("Error Patching CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error Patching CosmosDB Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err := future.WaitForCompletionRef(context.Background(), client.Client); err != nil {
-		return fmt.Errorf("Error waiting on patch future CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting on patch future CosmosDB Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	id := resp.ID
@@ -437,7 +443,7 @@ func resourceArmCosmosDBAccountUpdate(d *schema.ResourceData, meta interface{})
 	//get existing locations (if exists)
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM CosmosDB Account '%s': %s", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM CosmosDB Account '%s': %s", name, azure.FormatError(err))
 	}
 
 	oldLocations := make([]documentdb.Location, 0)
@@ -476,6 +482,28 @@ func resourceArmCosmosDBAccountUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error updating CosmosDB Account %q properties (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	//if only the failover priorities of the existing locations have changed (e.g. to fail over to another region)
+	//they can be changed in-place rather than removing and re-adding each location
+	if failoverPolicies, ok := cosmosDBAccountFailoverPriorityChanges(oldLocationsMap, newLocations); ok {
+		log.Printf("[INFO] Changing the Failover Priorities of CosmosDB Account %q (Resource Group %q)", name, resourceGroup)
+		future, err := client.FailoverPriorityChange(ctx, resourceGroup, name, documentdb.FailoverPolicies{
+			FailoverPolicies: &failoverPolicies,
+		})
+		if err != nil {
+			return fmt.Errorf("Error changing the Failover Priorities of CosmosDB Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Failover Priorities of CosmosDB Account %q (Resource Group %q) to change: %s", name, resourceGroup, azure.FormatError(err))
+		}
+
+		for _, policy := range failoverPolicies {
+			location := oldLocationsMap[*policy.LocationName]
+			location.FailoverPriority = policy.FailoverPriority
+			oldLocationsMap[*policy.LocationName] = location
+		}
+	}
+
 	//determine if any locations have been renamed/priority reordered and remove them
 	removedOne := false
 	for _, l := range newLocations {
@@ -534,11 +562,11 @@ func resourceArmCosmosDBAccountUpdate(d *schema.ResourceData, meta interface{})
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("Error Patching CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error Patching CosmosDB Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err := future.WaitForCompletionRef(context.Background(), client.Client); err != nil {
-		return fmt.Errorf("Error waiting on patch future CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting on patch future CosmosDB Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.SetId(*id)
@@ -565,7 +593,7 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on AzureRM CosmosDB Account '%s': %s", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM CosmosDB Account '%s': %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -630,6 +658,14 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("write_endpoints", writeEndpoints)
 	}
 
+	if err = d.Set("read_locations", flattenAzureRmCosmosDBAccountRegionalEndpoints(resp.ReadLocations)); err != nil {
+		return fmt.Errorf("Error setting `read_locations`: %+v", err)
+	}
+
+	if err = d.Set("write_locations", flattenAzureRmCosmosDBAccountRegionalEndpoints(resp.WriteLocations)); err != nil {
+		return fmt.Errorf("Error setting `write_locations`: %+v", err)
+	}
+
 	// ListKeys returns a data structure containing a DatabaseAccountListReadOnlyKeysResult pointer
 	// implying that it also returns the read only keys, however this appears to not be the case
 	keys, err := client.ListKeys(ctx, resourceGroup, name)
@@ -640,7 +676,7 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("[ERROR] Unable to List Write keys for CosmosDB Account %s: %s", name, err)
+		return fmt.Errorf("[ERROR] Unable to List Write keys for CosmosDB Account %s: %s", name, azure.FormatError(err))
 	}
 	d.Set("primary_master_key", keys.PrimaryMasterKey)
 	d.Set("secondary_master_key", keys.SecondaryMasterKey)
@@ -653,7 +689,7 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("[ERROR] Unable to List read-only keys for CosmosDB Account %s: %s", name, err)
+		return fmt.Errorf("[ERROR] Unable to List read-only keys for CosmosDB Account %s: %s", name, azure.FormatError(err))
 	}
 	d.Set("primary_readonly_master_key", readonlyKeys.PrimaryReadonlyMasterKey)
 	d.Set("secondary_readonly_master_key", readonlyKeys.SecondaryReadonlyMasterKey)
@@ -666,7 +702,7 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("[ERROR] Unable to List connection strings for CosmosDB Account %s: %s", name, err)
+		return fmt.Errorf("[ERROR] Unable to List connection strings for CosmosDB Account %s: %s", name, azure.FormatError(err))
 	}
 
 	var connStrings []string
@@ -699,7 +735,7 @@ func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{})
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("Error issuing AzureRM delete request for CosmosDB Account '%s': %+v", name, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for CosmosDB Account '%s': %s", name, azure.FormatError(err))
 	}
 
 	//the SDK now will return a `WasNotFound` response even when still deleting
@@ -732,11 +768,11 @@ func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{})
 func resourceArmCosmosDBAccountApiUpsert(client documentdb.DatabaseAccountsClient, ctx context.Context, resourceGroup string, name string, account documentdb.DatabaseAccountCreateUpdateParameters) (*documentdb.DatabaseAccount, error) {
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, account)
 	if err != nil {
-		return nil, fmt.Errorf("Error creating/updating CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return nil, fmt.Errorf("Error creating/updating CosmosDB Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return nil, fmt.Errorf("Error waiting for the CosmosDB Account %q (Resource Group %q) to finish creating/updating: %+v", name, resourceGroup, err)
+		return nil, fmt.Errorf("Error waiting for the CosmosDB Account %q (Resource Group %q) to finish creating/updating: %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	//if a replication location is added or removed it can take some time to provision
@@ -845,7 +881,7 @@ func expandAzureRmCosmosDBAccountGeoLocations(databaseName string, d *schema.Res
 	return locations, nil
 }
 
-//todo remove when deprecated field `failover_policy` is
+// todo remove when deprecated field `failover_policy` is
 func expandAzureRmCosmosDBAccountFailoverPolicy(databaseName string, d *schema.ResourceData) ([]documentdb.Location, error) {
 
 	input := d.Get("failover_policy").(*schema.Set).List()
@@ -894,6 +930,38 @@ func expandAzureRmCosmosDBAccountFailoverPolicy(databaseName string, d *schema.R
 	return locations, nil
 }
 
+// cosmosDBAccountFailoverPriorityChanges returns the Failover Policies to apply when the only change between
+// the existing and new locations is their failover priority - otherwise the locations need to be re-created
+func cosmosDBAccountFailoverPriorityChanges(oldLocations map[string]documentdb.Location, newLocations []documentdb.Location) ([]documentdb.FailoverPolicy, bool) {
+	if len(oldLocations) != len(newLocations) {
+		return nil, false
+	}
+
+	changed := false
+	policies := make([]documentdb.FailoverPolicy, 0)
+	for _, l := range newLocations {
+		existing, ok := oldLocations[*l.LocationName]
+		if !ok || existing.FailoverPriority == nil {
+			return nil, false
+		}
+
+		if *existing.FailoverPriority != *l.FailoverPriority {
+			changed = true
+		}
+
+		policies = append(policies, documentdb.FailoverPolicy{
+			LocationName:     l.LocationName,
+			FailoverPriority: l.FailoverPriority,
+		})
+	}
+
+	if !changed {
+		return nil, false
+	}
+
+	return policies, true
+}
+
 func expandAzureRmCosmosDBAccountCapabilities(d *schema.ResourceData) *[]documentdb.Capability {
 
 	capabilities := d.Get("capabilities").(*schema.Set).List()
@@ -932,7 +1000,7 @@ func flattenAzureRmCosmosDBAccountConsistencyPolicy(policy *documentdb.Consisten
 	return []interface{}{result}
 }
 
-//todo remove when failover_policy field is removed
+// todo remove when failover_policy field is removed
 func flattenAzureRmCosmosDBAccountFailoverPolicy(list *[]documentdb.FailoverPolicy) *schema.Set {
 	results := schema.Set{
 		F: resourceAzureRMCosmosDBAccountFailoverPolicyHash,
@@ -1018,7 +1086,7 @@ func flattenAzureRmCosmosDBAccountVirtualNetworkRules(rules *[]documentdb.Virtua
 	return &results
 }
 
-//todo remove once deprecated field `failover_policy` is removed
+// todo remove once deprecated field `failover_policy` is removed
 func resourceAzureRMCosmosDBAccountFailoverPolicyHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -1068,3 +1136,64 @@ func resourceAzureRMCosmosDBAccountVirtualNetworkRuleHash(v interface{}) int {
 
 	return hashcode.String(buf.String())
 }
+
+func cosmosDBAccountRegionalEndpointsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"location": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"endpoint": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"failover_priority": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenAzureRmCosmosDBAccountRegionalEndpoints(input *[]documentdb.Location) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, l := range *input {
+		result := make(map[string]interface{})
+
+		if l.ID != nil {
+			result["id"] = *l.ID
+		}
+
+		if l.LocationName != nil {
+			result["location"] = azureRMNormalizeLocation(*l.LocationName)
+		}
+
+		if l.DocumentEndpoint != nil {
+			result["endpoint"] = *l.DocumentEndpoint
+		}
+
+		if l.FailoverPriority != nil {
+			result["failover_priority"] = int(*l.FailoverPriority)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
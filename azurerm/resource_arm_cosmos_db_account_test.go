@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 // TODO: refactor the test configs
@@ -537,6 +538,106 @@ func TestAccAzureRMCosmosDBAccount_geoReplicated_rename(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMCosmosDBAccount_geoReplicated_failoverPriorityChange(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_cosmosdb_account.test"
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBAccount_geoReplicated(ri, location, altLocation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkAccAzureRMCosmosDBAccount_basic(resourceName, location, string(documentdb.BoundedStaleness), 2),
+					resource.TestCheckResourceAttr(resourceName, "write_locations.0.location", azureRMNormalizeLocation(location)),
+				),
+			},
+			{
+				Config: testAccAzureRMCosmosDBAccount_geoReplicated_failedOver(ri, location, altLocation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkAccAzureRMCosmosDBAccount_basic(resourceName, location, string(documentdb.BoundedStaleness), 2),
+					resource.TestCheckResourceAttr(resourceName, "write_locations.0.location", azureRMNormalizeLocation(altLocation)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestCosmosDBAccountFailoverPriorityChanges(t *testing.T) {
+	existing := map[string]documentdb.Location{
+		"westeurope": {
+			LocationName:     utils.String("westeurope"),
+			FailoverPriority: utils.Int32(0),
+		},
+		"northeurope": {
+			LocationName:     utils.String("northeurope"),
+			FailoverPriority: utils.Int32(1),
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Input    []documentdb.Location
+		Expected bool
+	}{
+		{
+			Name: "Unchanged",
+			Input: []documentdb.Location{
+				{LocationName: utils.String("westeurope"), FailoverPriority: utils.Int32(0)},
+				{LocationName: utils.String("northeurope"), FailoverPriority: utils.Int32(1)},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Priorities Swapped",
+			Input: []documentdb.Location{
+				{LocationName: utils.String("westeurope"), FailoverPriority: utils.Int32(1)},
+				{LocationName: utils.String("northeurope"), FailoverPriority: utils.Int32(0)},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Location Added",
+			Input: []documentdb.Location{
+				{LocationName: utils.String("westeurope"), FailoverPriority: utils.Int32(1)},
+				{LocationName: utils.String("northeurope"), FailoverPriority: utils.Int32(0)},
+				{LocationName: utils.String("westus"), FailoverPriority: utils.Int32(2)},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Location Replaced",
+			Input: []documentdb.Location{
+				{LocationName: utils.String("westeurope"), FailoverPriority: utils.Int32(1)},
+				{LocationName: utils.String("westus"), FailoverPriority: utils.Int32(0)},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		policies, actual := cosmosDBAccountFailoverPriorityChanges(existing, v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+
+		if actual && len(policies) != len(v.Input) {
+			t.Fatalf("Expected %d Failover Policies but got %d", len(v.Input), len(policies))
+		}
+	}
+}
+
 func TestAccAzureRMCosmosDBAccount_virtualNetworkFilter(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	resourceName := "azurerm_cosmosdb_account.test"
@@ -837,6 +938,36 @@ func testAccAzureRMCosmosDBAccount_geoReplicated(rInt int, location string, altL
     `, altLocation))
 }
 
+func testAccAzureRMCosmosDBAccount_geoReplicated_failedOver(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  offer_type          = "Standard"
+
+  consistency_policy {
+    consistency_level = "%s"
+  }
+
+  geo_location {
+    location          = "${azurerm_resource_group.test.location}"
+    failover_priority = 1
+  }
+
+  geo_location {
+    location          = "%s"
+    failover_priority = 0
+  }
+}
+`, rInt, location, rInt, string(documentdb.BoundedStaleness), altLocation)
+}
+
 func testAccAzureRMCosmosDBAccount_multiMaster(rInt int, location string, altLocation string) string {
 	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.BoundedStaleness), "", fmt.Sprintf(`
         enable_multiple_write_locations = true
@@ -941,6 +1072,10 @@ func checkAccAzureRMCosmosDBAccount_basic(resourceName string, location string,
 		resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
 		resource.TestCheckResourceAttr(resourceName, "read_endpoints.#", strconv.Itoa(locationCount)),
 		resource.TestCheckResourceAttr(resourceName, "write_endpoints.#", "1"),
+		resource.TestCheckResourceAttr(resourceName, "read_locations.#", strconv.Itoa(locationCount)),
+		resource.TestCheckResourceAttr(resourceName, "write_locations.#", "1"),
+		resource.TestCheckResourceAttr(resourceName, "write_locations.0.failover_priority", "0"),
+		resource.TestCheckResourceAttrSet(resourceName, "write_locations.0.endpoint"),
 		resource.TestCheckResourceAttr(resourceName, "enable_multiple_write_locations", "false"),
 		resource.TestCheckResourceAttrSet(resourceName, "primary_master_key"),
 		resource.TestCheckResourceAttrSet(resourceName, "secondary_master_key"),
@@ -12,6 +12,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/datalake/store/2016-11-01/filesystem"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -69,7 +70,7 @@ func resourceArmDataLakeStoreFileCreate(d *schema.ResourceData, meta interface{}
 		existing, err := client.GetFileStatus(ctx, accountName, remoteFilePath, utils.Bool(true))
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Data Lake Store File %q (Account %q): %s", remoteFilePath, accountName, err)
+				return fmt.Errorf("Error checking for presence of existing Data Lake Store File %q (Account %q): %s", remoteFilePath, accountName, azure.FormatError(err))
 			}
 		}
 
@@ -85,7 +86,7 @@ func resourceArmDataLakeStoreFileCreate(d *schema.ResourceData, meta interface{}
 	defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing Data Lake Store File %q", localFilePath))
 
 	if _, err = client.Create(ctx, accountName, remoteFilePath, nil, nil, filesystem.DATA, nil, nil); err != nil {
-		return fmt.Errorf("Error issuing create request for Data Lake Store File %q : %+v", remoteFilePath, err)
+		return fmt.Errorf("Error issuing create request for Data Lake Store File %q : %s", remoteFilePath, azure.FormatError(err))
 	}
 
 	buffer := make([]byte, chunkSize)
@@ -102,7 +103,7 @@ func resourceArmDataLakeStoreFileCreate(d *schema.ResourceData, meta interface{}
 		chunk := ioutil.NopCloser(bytes.NewReader(buffer[:n]))
 
 		if _, err = client.Append(ctx, accountName, remoteFilePath, chunk, nil, flag, nil, nil); err != nil {
-			return fmt.Errorf("Error transferring chunk for Data Lake Store File %q : %+v", remoteFilePath, err)
+			return fmt.Errorf("Error transferring chunk for Data Lake Store File %q : %s", remoteFilePath, azure.FormatError(err))
 		}
 	}
 
@@ -127,7 +128,7 @@ func resourceArmDataLakeStoreFileRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Azure Data Lake Store File %q (Account %q): %+v", id.filePath, id.storageAccountName, err)
+		return fmt.Errorf("Error making Read request on Azure Data Lake Store File %q (Account %q): %s", id.filePath, id.storageAccountName, azure.FormatError(err))
 	}
 
 	d.Set("account_name", id.storageAccountName)
@@ -148,7 +149,7 @@ func resourceArmDataLakeStoreFileDelete(d *schema.ResourceData, meta interface{}
 	resp, err := client.Delete(ctx, id.storageAccountName, id.filePath, utils.Bool(false))
 	if err != nil {
 		if !response.WasNotFound(resp.Response.Response) {
-			return fmt.Errorf("Error issuing delete request for Data Lake Store File %q (Account %q): %+v", id.filePath, id.storageAccountName, err)
+			return fmt.Errorf("Error issuing delete request for Data Lake Store File %q (Account %q): %s", id.filePath, id.storageAccountName, azure.FormatError(err))
 		}
 	}
 
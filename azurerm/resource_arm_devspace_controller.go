@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -70,10 +71,11 @@ func resourceArmDevSpaceController() *schema.Resource {
 			},
 
 			"target_container_host_resource_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"target_container_host_credentials_base64": {
@@ -107,7 +109,7 @@ func resourceArmDevSpaceControllerCreate(d *schema.ResourceData, meta interface{
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing DevSpace Controller %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing DevSpace Controller %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -138,16 +140,16 @@ func resourceArmDevSpaceControllerCreate(d *schema.ResourceData, meta interface{
 
 	future, err := client.Create(ctx, resGroup, name, controller)
 	if err != nil {
-		return fmt.Errorf("Error creating DevSpace Controller %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error creating DevSpace Controller %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of DevSpace Controller %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error waiting for creation of DevSpace Controller %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	result, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving DevSpace %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving DevSpace %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if result.ID == nil {
@@ -177,7 +179,7 @@ func resourceArmDevSpaceControllerRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, err)
+		return fmt.Errorf("Error making Read request on DevSpace Controller %q (Resource Group %q): %s", name, resGroupName, azure.FormatError(err))
 	}
 
 	d.Set("name", result.Name)
@@ -217,7 +219,7 @@ func resourceArmDevSpaceControllerUpdate(d *schema.ResourceData, meta interface{
 
 	result, err := client.Update(ctx, resGroupName, name, params)
 	if err != nil {
-		return fmt.Errorf("Error updating DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, err)
+		return fmt.Errorf("Error updating DevSpace Controller %q (Resource Group %q): %s", name, resGroupName, azure.FormatError(err))
 	}
 
 	if result.ID == nil {
@@ -241,11 +243,11 @@ func resourceArmDevSpaceControllerDelete(d *schema.ResourceData, meta interface{
 
 	future, err := client.Delete(ctx, resGroupName, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, err)
+		return fmt.Errorf("Error deleting DevSpace Controller %q (Resource Group %q): %s", name, resGroupName, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the deletion of DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, err)
+		return fmt.Errorf("Error waiting for the deletion of DevSpace Controller %q (Resource Group %q): %s", name, resGroupName, azure.FormatError(err))
 	}
 
 	return nil
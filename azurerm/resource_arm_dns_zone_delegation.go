@@ -0,0 +1,322 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDnsZoneDelegation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsZoneDelegationCreateUpdate,
+		Read:   resourceArmDnsZoneDelegationRead,
+		Update: resourceArmDnsZoneDelegationCreateUpdate,
+		Delete: resourceArmDnsZoneDelegationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmDnsZoneDelegationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"zone_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"child_zone_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"child_name_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceArmDnsZoneDelegationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dnsClient
+	zonesClient := meta.(*ArmClient).zonesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	zoneName := d.Get("zone_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	childZoneId, err := parseAzureResourceID(d.Get("child_zone_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `child_zone_id`: %+v", err)
+	}
+	childResGroup := childZoneId.ResourceGroup
+	childZoneName := dnsZoneNameFromResourceID(childZoneId)
+	if childZoneName == "" {
+		return fmt.Errorf("Error parsing `child_zone_id`: %q is not the ID of a DNS Zone", d.Get("child_zone_id").(string))
+	}
+
+	name, err := dnsZoneDelegationRecordName(childZoneName, zoneName)
+	if err != nil {
+		return err
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, zoneName, name, dns.NS)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing DNS NS Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dns_zone_delegation", *existing.ID)
+		}
+	}
+
+	childZone, err := zonesClient.Get(ctx, childResGroup, childZoneName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Child DNS Zone %q (Resource Group %q): %s", childZoneName, childResGroup, azure.FormatError(err))
+	}
+
+	if childZone.ZoneProperties == nil || childZone.ZoneProperties.NameServers == nil || len(*childZone.ZoneProperties.NameServers) == 0 {
+		return fmt.Errorf("Error: no Name Servers were returned for Child DNS Zone %q (Resource Group %q)", childZoneName, childResGroup)
+	}
+
+	records := make([]dns.NsRecord, 0)
+	for _, v := range *childZone.ZoneProperties.NameServers {
+		nameServer := v
+		records = append(records, dns.NsRecord{
+			Nsdname: &nameServer,
+		})
+	}
+
+	ttl := int64(d.Get("ttl").(int))
+	parameters := dns.RecordSet{
+		Name: &name,
+		RecordSetProperties: &dns.RecordSetProperties{
+			TTL:       &ttl,
+			NsRecords: &records,
+		},
+	}
+
+	log.Printf("[INFO] Delegating DNS Zone %q from DNS Zone %q (Resource Group %q)", childZoneName, zoneName, resGroup)
+	eTag := ""
+	ifNoneMatch := "" // set to empty to allow updates to records after creation
+	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.NS, parameters, eTag, ifNoneMatch); err != nil {
+		return fmt.Errorf("Error creating/updating DNS NS Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, azure.FormatError(err))
+	}
+
+	resp, err := client.Get(ctx, resGroup, zoneName, name, dns.NS)
+	if err != nil {
+		return fmt.Errorf("Error retrieving DNS NS Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, azure.FormatError(err))
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read DNS NS Record %q (Zone %q / Resource Group %q) ID", name, zoneName, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDnsZoneDelegationRead(d, meta)
+}
+
+func resourceArmDnsZoneDelegationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dnsClient
+	zonesClient := meta.(*ArmClient).zonesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["NS"]
+	zoneName := id.Path["dnszones"]
+
+	resp, err := client.Get(ctx, resGroup, zoneName, name, dns.NS)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DNS NS Record %q was not found in Zone %q (Resource Group %q) - removing from state", name, zoneName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DNS NS Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, azure.FormatError(err))
+	}
+
+	d.Set("name", name)
+	d.Set("zone_name", zoneName)
+	d.Set("resource_group_name", resGroup)
+	d.Set("ttl", resp.TTL)
+
+	// when importing the Child Zone is assumed to be in the same Resource Group as the Parent Zone
+	if _, ok := d.GetOk("child_zone_id"); !ok {
+		childZoneName := fmt.Sprintf("%s.%s", name, zoneName)
+		d.Set("child_zone_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s", id.SubscriptionID, resGroup, childZoneName))
+	}
+
+	nameServers := make([]string, 0)
+	if props := resp.RecordSetProperties; props != nil && props.NsRecords != nil {
+		nameServers = flattenAzureRmDnsNsRecords(props.NsRecords)
+	}
+	if err := d.Set("name_servers", nameServers); err != nil {
+		return fmt.Errorf("Error setting `name_servers`: %+v", err)
+	}
+
+	// the Name Servers of the Child Zone change when it's recreated, in which case the delegation needs updating
+	childNameServers := make([]string, 0)
+	childZoneId, err := parseAzureResourceID(d.Get("child_zone_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `child_zone_id`: %+v", err)
+	}
+	childResGroup := childZoneId.ResourceGroup
+	childZoneName := dnsZoneNameFromResourceID(childZoneId)
+
+	childZone, err := zonesClient.Get(ctx, childResGroup, childZoneName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(childZone.Response) {
+			return fmt.Errorf("Error retrieving Child DNS Zone %q (Resource Group %q): %s", childZoneName, childResGroup, azure.FormatError(err))
+		}
+
+		log.Printf("[DEBUG] Child DNS Zone %q (Resource Group %q) was not found", childZoneName, childResGroup)
+	} else if props := childZone.ZoneProperties; props != nil && props.NameServers != nil {
+		childNameServers = *props.NameServers
+	}
+	if err := d.Set("child_name_servers", childNameServers); err != nil {
+		return fmt.Errorf("Error setting `child_name_servers`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDnsZoneDelegationCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// both are refreshed in the Read, so any difference means the Child Zone's Name Servers have changed
+	nameServers := *utils.ExpandStringArray(d.Get("name_servers").([]interface{}))
+	childNameServers := *utils.ExpandStringArray(d.Get("child_name_servers").([]interface{}))
+	if len(childNameServers) == 0 || dnsNameServersEqual(nameServers, childNameServers) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] The Name Servers of the Child DNS Zone have changed from %v to %v - updating the delegation", nameServers, childNameServers)
+	if err := d.SetNewComputed("name_servers"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("child_name_servers")
+}
+
+func resourceArmDnsZoneDelegationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["NS"]
+	zoneName := id.Path["dnszones"]
+
+	resp, err := client.Delete(ctx, resGroup, zoneName, name, dns.NS, "")
+	if err != nil {
+		if response.WasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DNS NS Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
+// dnsNameServersEqual compares two lists of Name Servers, ignoring ordering, casing and trailing dots
+func dnsNameServersEqual(first []string, second []string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	normalise := func(input []string) []string {
+		output := make([]string, 0, len(input))
+		for _, v := range input {
+			output = append(output, strings.TrimSuffix(strings.ToLower(v), "."))
+		}
+		sort.Strings(output)
+		return output
+	}
+
+	firstNormalised := normalise(first)
+	secondNormalised := normalise(second)
+	for i := range firstNormalised {
+		if firstNormalised[i] != secondNormalised[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func dnsZoneNameFromResourceID(id *ResourceID) string {
+	// the casing of this segment differs depending on whether the ID was returned from the API
+	for k, v := range id.Path {
+		if strings.EqualFold(k, "dnszones") {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// dnsZoneDelegationRecordName returns the name of the NS Record within the Parent Zone which delegates to the Child Zone
+// e.g. a Child Zone of `dev.example.com` within the Parent Zone `example.com` returns `dev`
+func dnsZoneDelegationRecordName(childZoneName string, parentZoneName string) (string, error) {
+	suffix := fmt.Sprintf(".%s", strings.TrimSuffix(parentZoneName, "."))
+	child := strings.TrimSuffix(childZoneName, ".")
+
+	if !strings.HasSuffix(strings.ToLower(child), strings.ToLower(suffix)) || len(child) == len(suffix) {
+		return "", fmt.Errorf("DNS Zone %q is not a child of the DNS Zone %q", childZoneName, parentZoneName)
+	}
+
+	return child[:len(child)-len(suffix)], nil
+}
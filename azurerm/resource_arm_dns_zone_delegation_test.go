@@ -0,0 +1,404 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestDnsZoneDelegationRecordName(t *testing.T) {
+	cases := []struct {
+		ChildZone  string
+		ParentZone string
+		Expected   string
+		Error      bool
+	}{
+		{
+			ChildZone:  "dev.example.com",
+			ParentZone: "example.com",
+			Expected:   "dev",
+		},
+		{
+			ChildZone:  "api.dev.example.com",
+			ParentZone: "example.com",
+			Expected:   "api.dev",
+		},
+		{
+			ChildZone:  "Dev.Example.com.",
+			ParentZone: "example.com",
+			Expected:   "Dev",
+		},
+		{
+			ChildZone:  "example.com",
+			ParentZone: "example.com",
+			Error:      true,
+		},
+		{
+			ChildZone:  "devexample.com",
+			ParentZone: "example.com",
+			Error:      true,
+		},
+		{
+			ChildZone:  "dev.example.org",
+			ParentZone: "example.com",
+			Error:      true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q in %q", v.ChildZone, v.ParentZone)
+
+		actual, err := dnsZoneDelegationRecordName(v.ChildZone, v.ParentZone)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but got %q", actual)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestDnsNameServersEqual(t *testing.T) {
+	testData := []struct {
+		First    []string
+		Second   []string
+		Expected bool
+	}{
+		{
+			First:    []string{},
+			Second:   []string{},
+			Expected: true,
+		},
+		{
+			First:    []string{"ns1-01.azure-dns.com.", "ns2-01.azure-dns.net."},
+			Second:   []string{"ns1-01.azure-dns.com.", "ns2-01.azure-dns.net."},
+			Expected: true,
+		},
+		{
+			First:    []string{"ns2-01.azure-dns.net.", "ns1-01.azure-dns.com."},
+			Second:   []string{"ns1-01.azure-dns.com.", "ns2-01.azure-dns.net."},
+			Expected: true,
+		},
+		{
+			First:    []string{"NS1-01.azure-dns.com", "ns2-01.azure-dns.net."},
+			Second:   []string{"ns1-01.azure-dns.com.", "ns2-01.azure-dns.net"},
+			Expected: true,
+		},
+		{
+			First:    []string{"ns1-01.azure-dns.com.", "ns2-01.azure-dns.net."},
+			Second:   []string{"ns1-02.azure-dns.com.", "ns2-02.azure-dns.net."},
+			Expected: false,
+		},
+		{
+			First:    []string{"ns1-01.azure-dns.com."},
+			Second:   []string{"ns1-01.azure-dns.com.", "ns2-01.azure-dns.net."},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %v / %v", v.First, v.Second)
+
+		actual := dnsNameServersEqual(v.First, v.Second)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestAccAzureRMDnsZoneDelegation_basic(t *testing.T) {
+	resourceName := "azurerm_dns_zone_delegation.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsZoneDelegation_basic(ri, testLocation(), 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneDelegationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "child"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+					resource.TestCheckResourceAttrPair(resourceName, "name_servers.#", "azurerm_dns_zone.child", "name_servers.#"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsZoneDelegation_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dns_zone_delegation.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsZoneDelegation_basic(ri, location, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneDelegationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDnsZoneDelegation_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_dns_zone_delegation"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsZoneDelegation_updateTTL(t *testing.T) {
+	resourceName := "azurerm_dns_zone_delegation.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsZoneDelegation_basic(ri, location, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneDelegationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccAzureRMDnsZoneDelegation_basic(ri, location, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneDelegationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsZoneDelegation_childZoneRecreated(t *testing.T) {
+	resourceName := "azurerm_dns_zone_delegation.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	resourceGroup := fmt.Sprintf("acctestRG-%d", ri)
+	childZoneName := fmt.Sprintf("child.acctestzone%d.com", ri)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsZoneDelegation_basic(ri, location, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneDelegationExists(resourceName),
+					testCheckAzureRMDnsZoneDelegationMatchesChildZone(resourceName),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := testRecreateAzureRMDnsZone(resourceGroup, childZoneName); err != nil {
+						t.Fatalf("Error recreating Child DNS Zone: %+v", err)
+					}
+				},
+				Config: testAccAzureRMDnsZoneDelegation_basic(ri, location, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneDelegationExists(resourceName),
+					testCheckAzureRMDnsZoneDelegationMatchesChildZone(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testRecreateAzureRMDnsZone(resourceGroup string, zoneName string) error {
+	client := testAccProvider.Meta().(*ArmClient).zonesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	future, err := client.Delete(ctx, resourceGroup, zoneName, "")
+	if err != nil {
+		return fmt.Errorf("Error deleting DNS Zone %q (Resource Group %q): %+v", zoneName, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of DNS Zone %q (Resource Group %q): %+v", zoneName, resourceGroup, err)
+	}
+
+	parameters := dns.Zone{
+		Location: utils.String("global"),
+		ZoneProperties: &dns.ZoneProperties{
+			ZoneType: dns.Public,
+		},
+	}
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, zoneName, parameters, "", ""); err != nil {
+		return fmt.Errorf("Error creating DNS Zone %q (Resource Group %q): %+v", zoneName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMDnsZoneDelegationMatchesChildZone(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		childZoneName := fmt.Sprintf("%s.%s", name, zoneName)
+
+		client := testAccProvider.Meta().(*ArmClient).dnsClient
+		zonesClient := testAccProvider.Meta().(*ArmClient).zonesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		record, err := client.Get(ctx, resourceGroup, zoneName, name, dns.NS)
+		if err != nil {
+			return fmt.Errorf("Bad: Get DNS NS Record: %+v", err)
+		}
+
+		childZone, err := zonesClient.Get(ctx, resourceGroup, childZoneName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get DNS Zone: %+v", err)
+		}
+
+		nameServers := make([]string, 0)
+		if props := record.RecordSetProperties; props != nil && props.NsRecords != nil {
+			nameServers = flattenAzureRmDnsNsRecords(props.NsRecords)
+		}
+
+		childNameServers := make([]string, 0)
+		if props := childZone.ZoneProperties; props != nil && props.NameServers != nil {
+			childNameServers = *props.NameServers
+		}
+
+		if !dnsNameServersEqual(nameServers, childNameServers) {
+			return fmt.Errorf("Bad: DNS NS Record %q (Zone %q) has Name Servers %v but the Child Zone has %v", name, zoneName, nameServers, childNameServers)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDnsZoneDelegationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).dnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, zoneName, name, dns.NS)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: DNS NS Record %q (Zone %q / Resource Group %q) does not exist", name, zoneName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get DNS NS Record: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDnsZoneDelegationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dnsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dns_zone_delegation" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, zoneName, name, dns.NS)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DNS NS Record %q (Zone %q / Resource Group %q) still exists", name, zoneName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDnsZoneDelegation_basic(rInt int, location string, ttl int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "parent" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_zone" "child" {
+  name                = "child.${azurerm_dns_zone.parent.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_zone_delegation" "test" {
+  zone_name           = "${azurerm_dns_zone.parent.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  child_zone_id       = "${azurerm_dns_zone.child.id}"
+  ttl                 = %d
+}
+`, rInt, location, rInt, ttl)
+}
+
+func testAccAzureRMDnsZoneDelegation_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMDnsZoneDelegation_basic(rInt, location, 3600)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_delegation" "import" {
+  zone_name           = "${azurerm_dns_zone_delegation.test.zone_name}"
+  resource_group_name = "${azurerm_dns_zone_delegation.test.resource_group_name}"
+  child_zone_id       = "${azurerm_dns_zone_delegation.test.child_zone_id}"
+  ttl                 = "${azurerm_dns_zone_delegation.test.ttl}"
+}
+`, template)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2018-09-15-preview/eventgrid"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -65,7 +66,7 @@ func resourceArmEventGridTopicCreateUpdate(d *schema.ResourceData, meta interfac
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing EventGrid Topic %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing EventGrid Topic %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -126,12 +127,12 @@ func resourceArmEventGridTopicRead(d *schema.ResourceData, meta interface{}) err
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on EventGrid Topic '%s': %+v", name, err)
+		return fmt.Errorf("Error making Read request on EventGrid Topic '%s': %s", name, azure.FormatError(err))
 	}
 
 	keys, err := client.ListSharedAccessKeys(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Shared Access Keys for EventGrid Topic '%s': %+v", name, err)
+		return fmt.Errorf("Error retrieving Shared Access Keys for EventGrid Topic '%s': %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -168,14 +169,14 @@ func resourceArmEventGridTopicDelete(d *schema.ResourceData, meta interface{}) e
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Event Grid Topic %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Event Grid Topic %q: %s", name, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Event Grid Topic %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Event Grid Topic %q: %s", name, azure.FormatError(err))
 	}
 
 	return nil
@@ -71,7 +71,7 @@ func resourceArmEventHubConsumerGroupCreateUpdate(d *schema.ResourceData, meta i
 		existing, err := client.Get(ctx, resGroup, namespaceName, eventHubName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing EventHub Consumer Group %q (EventHub %q / Namespace %q / Resource Group %q): %s", name, eventHubName, namespaceName, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing EventHub Consumer Group %q (EventHub %q / Namespace %q / Resource Group %q): %s", name, eventHubName, namespaceName, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -127,7 +127,7 @@ func resourceArmEventHubConsumerGroupRead(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Azure EventHub Consumer Group %s: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Azure EventHub Consumer Group %s: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", name)
@@ -156,7 +156,7 @@ func resourceArmEventHubConsumerGroupDelete(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error issuing Azure ARM delete request of EventHub Consumer Group '%s': %+v", name, err)
+			return fmt.Errorf("Error issuing Azure ARM delete request of EventHub Consumer Group '%s': %s", name, azure.FormatError(err))
 		}
 	}
 
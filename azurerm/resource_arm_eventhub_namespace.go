@@ -121,7 +121,7 @@ func resourceArmEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta inter
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing EventHub Namespace %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing EventHub Namespace %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -161,7 +161,7 @@ func resourceArmEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error creating eventhub namespace: %+v", err)
+		return fmt.Errorf("Error creating eventhub namespace: %s", azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -195,7 +195,7 @@ func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on EventHub Namespace %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on EventHub Namespace %q: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -244,7 +244,7 @@ func resourceArmEventHubNamespaceDelete(d *schema.ResourceData, meta interface{}
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("Error issuing delete request of EventHub Namespace %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error issuing delete request of EventHub Namespace %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	return waitForEventHubNamespaceToBeDeleted(ctx, client, resGroup, name)
@@ -276,7 +276,7 @@ func eventHubNamespaceStateStatusCodeRefreshFunc(ctx context.Context, client eve
 			if utils.ResponseWasNotFound(res.Response) {
 				return res, strconv.Itoa(res.StatusCode), nil
 			}
-			return nil, "", fmt.Errorf("Error polling for the status of the EventHub Namespace %q (RG: %q): %+v", name, resourceGroup, err)
+			return nil, "", fmt.Errorf("Error polling for the status of the EventHub Namespace %q (RG: %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 
 		return res, strconv.Itoa(res.StatusCode), nil
@@ -9,6 +9,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -43,9 +45,10 @@ func resourceArmFunctionApp() *schema.Resource {
 			},
 
 			"app_service_plan_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"enabled": {
@@ -237,7 +240,7 @@ func resourceArmFunctionAppCreate(d *schema.ResourceData, meta interface{}) erro
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Function App %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Function App %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -252,7 +255,7 @@ func resourceArmFunctionAppCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	available, err := client.CheckNameAvailability(ctx, availabilityRequest)
 	if err != nil {
-		return fmt.Errorf("Error checking if the name %q was available: %+v", name, err)
+		return fmt.Errorf("Error checking if the name %q was available: %s", name, azure.FormatError(err))
 	}
 
 	if !*available.NameAvailable {
@@ -382,7 +385,7 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	_, err = client.UpdateApplicationSettings(ctx, resGroup, name, settings)
 	if err != nil {
-		return fmt.Errorf("Error updating Application Settings for Function App %q: %+v", name, err)
+		return fmt.Errorf("Error updating Application Settings for Function App %q: %s", name, azure.FormatError(err))
 	}
 
 	if d.HasChange("site_config") {
@@ -391,7 +394,7 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 			SiteConfig: &siteConfig,
 		}
 		if _, err := client.CreateOrUpdateConfiguration(ctx, resGroup, name, siteConfigResource); err != nil {
-			return fmt.Errorf("Error updating Configuration for Function App %q: %+v", name, err)
+			return fmt.Errorf("Error updating Configuration for Function App %q: %s", name, azure.FormatError(err))
 		}
 	}
 
@@ -403,7 +406,7 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		if _, err := client.UpdateConnectionStrings(ctx, resGroup, name, properties); err != nil {
-			return fmt.Errorf("Error updating Connection Strings for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Connection Strings for App Service %q: %s", name, azure.FormatError(err))
 		}
 	}
 
@@ -429,7 +432,7 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Function App %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Function App %q: %s", name, azure.FormatError(err))
 	}
 
 	appSettingsResp, err := client.ListApplicationSettings(ctx, resGroup, name)
@@ -439,12 +442,12 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Function App AppSettings %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Function App AppSettings %q: %s", name, azure.FormatError(err))
 	}
 
 	connectionStringsResp, err := client.ListConnectionStrings(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM Function App ConnectionStrings %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Function App ConnectionStrings %q: %s", name, azure.FormatError(err))
 	}
 
 	siteCredFuture, err := client.ListPublishingCredentials(ctx, resGroup, name)
@@ -457,7 +460,7 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 	}
 	siteCredResp, err := siteCredFuture.Result(client)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Site Credential %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Site Credential %q: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", name)
@@ -505,7 +508,7 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 
 	configResp, err := client.GetConfiguration(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM Function App Configuration %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Function App Configuration %q: %s", name, azure.FormatError(err))
 	}
 
 	siteConfig := flattenFunctionAppSiteConfig(configResp.SiteConfig)
@@ -596,7 +599,7 @@ func getFunctionAppServiceTier(ctx context.Context, appServicePlanId string, met
 	appServicePlansClient := meta.(*ArmClient).appServicePlansClient
 	appServicePlan, err := appServicePlansClient.Get(ctx, id.ResourceGroup, id.Path["serverfarms"])
 	if err != nil {
-		return "", fmt.Errorf("[ERROR] Could not retrieve App Service Plan ID %q: %+v", appServicePlanId, err)
+		return "", fmt.Errorf("[ERROR] Could not retrieve App Service Plan ID %q: %s", appServicePlanId, azure.FormatError(err))
 	}
 
 	if sku := appServicePlan.Sku; sku != nil {
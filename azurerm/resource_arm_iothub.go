@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -341,7 +342,7 @@ func resourceArmIotHubCreateUpdate(d *schema.ResourceData, meta interface{}) err
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing IoTHub %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing IoTHub %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -354,7 +355,7 @@ func resourceArmIotHubCreateUpdate(d *schema.ResourceData, meta interface{}) err
 		Name: &name,
 	})
 	if err != nil {
-		return fmt.Errorf("An error occurred checking if the IoTHub name was unique: %+v", err)
+		return fmt.Errorf("An error occurred checking if the IoTHub name was unique: %s", azure.FormatError(err))
 	}
 
 	if !*res.NameAvailable {
@@ -392,11 +393,11 @@ func resourceArmIotHubCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties, "")
 	if err != nil {
-		return fmt.Errorf("Error creating/updating IotHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating/updating IotHub %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the completion of the creating/updating of IotHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for the completion of the creating/updating of IotHub %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
@@ -428,12 +429,12 @@ func resourceArmIotHubRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving IotHub Client %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving IotHub Client %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	keysResp, err := client.ListKeys(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error listing keys for IoTHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error listing keys for IoTHub %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	keyList := keysResp.Response()
@@ -542,7 +543,7 @@ func iothubStateStatusCodeRefreshFunc(ctx context.Context, client devices.IotHub
 			if utils.ResponseWasNotFound(res.Response) {
 				return res, strconv.Itoa(res.StatusCode), nil
 			}
-			return nil, "", fmt.Errorf("Error polling for the status of the IotHub %q (RG: %q): %+v", name, resourceGroup, err)
+			return nil, "", fmt.Errorf("Error polling for the status of the IotHub %q (RG: %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 
 		return res, strconv.Itoa(res.StatusCode), nil
@@ -40,6 +40,8 @@ func resourceArmKeyVault() *schema.Resource {
 		MigrateState:  resourceAzureRMKeyVaultMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: resourceArmKeyVaultCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -124,6 +126,16 @@ func resourceArmKeyVault() *schema.Resource {
 				Optional: true,
 			},
 
+			"soft_delete_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"network_acls": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -179,7 +191,7 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Key Vault %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Key Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -193,6 +205,8 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	enabledForDeployment := d.Get("enabled_for_deployment").(bool)
 	enabledForDiskEncryption := d.Get("enabled_for_disk_encryption").(bool)
 	enabledForTemplateDeployment := d.Get("enabled_for_template_deployment").(bool)
+	softDeleteEnabled := d.Get("soft_delete_enabled").(bool)
+	purgeProtectionEnabled := d.Get("purge_protection_enabled").(bool)
 	tags := d.Get("tags").(map[string]interface{})
 
 	networkAclsRaw := d.Get("network_acls").([]interface{})
@@ -218,6 +232,14 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		Tags: expandTags(tags),
 	}
 
+	// the API doesn't accept `false` for either of these fields, so we only send them when enabled
+	if softDeleteEnabled {
+		parameters.Properties.EnableSoftDelete = utils.Bool(true)
+	}
+	if purgeProtectionEnabled {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(true)
+	}
+
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
 	azureRMLockByName(name, keyVaultResourceName)
@@ -241,12 +263,12 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	defer azureRMUnlockMultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
 
 	if _, err = client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
-		return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 	if read.ID == nil {
 		return fmt.Errorf("Cannot read KeyVault %s (resource Group %q) ID", name, resourceGroup)
@@ -278,6 +300,37 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	return resourceArmKeyVaultRead(d, meta)
 }
 
+func resourceArmKeyVaultCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	oldSoftDelete, newSoftDelete := d.GetChange("soft_delete_enabled")
+	oldPurgeProtection, newPurgeProtection := d.GetChange("purge_protection_enabled")
+
+	// a Key Vault which is being created (or recreated) has nothing to disable
+	if d.Id() == "" || d.HasChange("name") || d.HasChange("location") || d.HasChange("resource_group_name") {
+		oldSoftDelete = false
+		oldPurgeProtection = false
+	}
+
+	return validateKeyVaultSoftDeleteAndPurgeProtection(oldSoftDelete.(bool), newSoftDelete.(bool), oldPurgeProtection.(bool), newPurgeProtection.(bool))
+}
+
+// validateKeyVaultSoftDeleteAndPurgeProtection checks that Purge Protection is only enabled alongside Soft Delete,
+// and that neither is disabled once enabled - since otherwise the API returns an opaque error at apply time
+func validateKeyVaultSoftDeleteAndPurgeProtection(oldSoftDelete, newSoftDelete, oldPurgeProtection, newPurgeProtection bool) error {
+	if newPurgeProtection && !newSoftDelete {
+		return fmt.Errorf("`soft_delete_enabled` must be set to `true` when `purge_protection_enabled` is `true`")
+	}
+
+	if oldSoftDelete && !newSoftDelete {
+		return fmt.Errorf("once Soft Delete has been enabled it cannot be disabled")
+	}
+
+	if oldPurgeProtection && !newPurgeProtection {
+		return fmt.Errorf("once Purge Protection has been enabled it cannot be disabled")
+	}
+
+	return nil
+}
+
 func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
 	ctx := meta.(*ArmClient).StopContext
@@ -296,7 +349,7 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on KeyVault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on KeyVault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -310,6 +363,8 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("enabled_for_deployment", props.EnabledForDeployment)
 		d.Set("enabled_for_disk_encryption", props.EnabledForDiskEncryption)
 		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
+		d.Set("soft_delete_enabled", props.EnableSoftDelete)
+		d.Set("purge_protection_enabled", props.EnablePurgeProtection)
 		d.Set("vault_uri", props.VaultURI)
 
 		if err := d.Set("sku", flattenKeyVaultSku(props.Sku)); err != nil {
@@ -350,7 +405,7 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	// ensure we lock on the latest network names, to ensure we handle Azure's networking layer being limited to one change at a time
@@ -383,7 +438,7 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -28,12 +29,13 @@ func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"key_vault_id": {
-				Type:          schema.TypeString,
-				Optional:      true, //todo required in 2.0
-				Computed:      true, //todo removed in 2.0
-				ForceNew:      true,
-				ValidateFunc:  azure.ValidateResourceID,
-				ConflictsWith: []string{"vault_name"},
+				Type:             schema.TypeString,
+				Optional:         true, //todo required in 2.0
+				Computed:         true, //todo removed in 2.0
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+				ConflictsWith:    []string{"vault_name"},
 			},
 
 			//todo remove in 2.0
@@ -101,6 +103,8 @@ func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 
 			"secret_permissions": azure.SchemaKeyVaultSecretPermissions(),
 		},
+
+		CustomizeDiff: validateResourceIDsInProviderSubscription("key_vault_id"),
 	}
 }
 
@@ -155,7 +159,7 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %s", vaultName, resourceGroup, azure.FormatError(err))
 	}
 
 	// This is because azure doesn't have an 'id' for a keyvault access policy
@@ -237,18 +241,44 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 	}
 
 	if _, err = client.UpdateAccessPolicy(ctx, resourceGroup, vaultName, action, parameters); err != nil {
-		return fmt.Errorf("Error updating Access Policy (Object ID %q / Application ID %q) for Key Vault %q (Resource Group %q): %+v", objectId, applicationIdRaw, vaultName, resourceGroup, err)
+		return fmt.Errorf("Error updating Access Policy (Object ID %q / Application ID %q) for Key Vault %q (Resource Group %q): %s", objectId, applicationIdRaw, vaultName, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, vaultName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %s", vaultName, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
 		return fmt.Errorf("Cannot read KeyVault %q (Resource Group %q) ID", vaultName, resourceGroup)
 	}
 
+	// Access Policies are replicated after they've been updated, so using the Key Vault immediately can fail
+	description := fmt.Sprintf("Access Policy (Object ID %q / Application ID %q) for Key Vault %q (Resource Group %q)", objectId, applicationIdRaw, vaultName, resourceGroup)
+	if err := waitForEventualConsistency(meta.(*ArmClient), description, func() (bool, error) {
+		vault, err := client.Get(ctx, resourceGroup, vaultName)
+		if err != nil {
+			return false, fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %s", vaultName, resourceGroup, azure.FormatError(err))
+		}
+
+		if vault.Properties == nil {
+			return false, nil
+		}
+
+		policy, err := findKeyVaultAccessPolicy(vault.Properties.AccessPolicies, objectId, applicationIdRaw)
+		if err != nil {
+			return false, err
+		}
+
+		if action == keyvault.Remove {
+			return policy == nil, nil
+		}
+
+		return policy != nil, nil
+	}); err != nil {
+		return err
+	}
+
 	if d.IsNewResource() {
 		d.SetId(resourceId)
 	}
@@ -290,7 +320,7 @@ func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Azure KeyVault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+		return fmt.Errorf("Error making Read request on Azure KeyVault %q (Resource Group %q): %s", vaultName, resGroup, azure.FormatError(err))
 	}
 
 	policy, err := findKeyVaultAccessPolicy(resp.Properties.AccessPolicies, objectId, applicationId)
@@ -0,0 +1,234 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultCertificateContacts() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultCertificateContactsCreateUpdate,
+		Read:   resourceArmKeyVaultCertificateContactsRead,
+		Update: resourceArmKeyVaultCertificateContactsCreateUpdate,
+		Delete: resourceArmKeyVaultCertificateContactsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"contact": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"phone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmKeyVaultCertificateContactsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	vaultClient := meta.(*ArmClient).keyVaultClient
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	keyVaultId := d.Get("key_vault_id").(string)
+
+	keyVaultBaseUrl, err := azure.GetKeyVaultBaseUrlFromID(ctx, vaultClient, keyVaultId)
+	if err != nil {
+		return fmt.Errorf("Error looking up Certificate Contacts vault url from id %q: %+v", keyVaultId, err)
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.GetCertificateContacts(ctx, keyVaultBaseUrl)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Certificate Contacts (Key Vault %q): %s", keyVaultBaseUrl, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_key_vault_certificate_contacts", *existing.ID)
+		}
+	}
+
+	contacts := keyvault.Contacts{
+		ContactList: expandKeyVaultCertificateContacts(d.Get("contact").([]interface{})),
+	}
+
+	if _, err := client.SetCertificateContacts(ctx, keyVaultBaseUrl, contacts); err != nil {
+		return fmt.Errorf("Error setting Certificate Contacts (Key Vault %q): %s", keyVaultBaseUrl, azure.FormatError(err))
+	}
+
+	read, err := client.GetCertificateContacts(ctx, keyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Certificate Contacts (Key Vault %q): %s", keyVaultBaseUrl, azure.FormatError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Certificate Contacts ID (Key Vault %q)", keyVaultBaseUrl)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKeyVaultCertificateContactsRead(d, meta)
+}
+
+func resourceArmKeyVaultCertificateContactsRead(d *schema.ResourceData, meta interface{}) error {
+	vaultClient := meta.(*ArmClient).keyVaultClient
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	keyVaultBaseUrl, err := parseKeyVaultCertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultId, err := azure.GetKeyVaultIDFromBaseUrl(ctx, vaultClient, keyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", keyVaultBaseUrl, err)
+	}
+	if keyVaultId == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", keyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetCertificateContacts(ctx, keyVaultBaseUrl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Certificate Contacts were not found in Key Vault at URI %q - removing from state", keyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Certificate Contacts (Key Vault %q): %s", keyVaultBaseUrl, azure.FormatError(err))
+	}
+
+	d.Set("key_vault_id", keyVaultId)
+
+	if err := d.Set("contact", flattenKeyVaultCertificateContacts(resp.ContactList)); err != nil {
+		return fmt.Errorf("Error setting `contact`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultCertificateContactsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	keyVaultBaseUrl, err := parseKeyVaultCertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteCertificateContacts(ctx, keyVaultBaseUrl)
+	if err != nil {
+		if !response.WasNotFound(resp.Response.Response) {
+			return fmt.Errorf("Error deleting Certificate Contacts (Key Vault %q): %s", keyVaultBaseUrl, azure.FormatError(err))
+		}
+	}
+
+	return nil
+}
+
+func parseKeyVaultCertificateContactsID(id string) (string, error) {
+	// example: https://tharvey-keyvault.vault.azure.net/certificates/contacts
+	idURL, err := url.ParseRequestURI(id)
+	if err != nil {
+		return "", fmt.Errorf("Cannot parse Key Vault Certificate Contacts ID: %s", err)
+	}
+
+	path := strings.Trim(idURL.Path, "/")
+	if !strings.EqualFold(path, "certificates/contacts") {
+		return "", fmt.Errorf("Key Vault Certificate Contacts ID should have the path `certificates/contacts`, got %q", path)
+	}
+
+	return fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host), nil
+}
+
+func expandKeyVaultCertificateContacts(input []interface{}) *[]keyvault.Contact {
+	results := make([]keyvault.Contact, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		contact := keyvault.Contact{
+			EmailAddress: utils.String(v["email"].(string)),
+		}
+
+		if name := v["name"].(string); name != "" {
+			contact.Name = utils.String(name)
+		}
+
+		if phone := v["phone"].(string); phone != "" {
+			contact.Phone = utils.String(phone)
+		}
+
+		results = append(results, contact)
+	}
+
+	return &results
+}
+
+func flattenKeyVaultCertificateContacts(input *[]keyvault.Contact) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		v := make(map[string]interface{})
+
+		if email := item.EmailAddress; email != nil {
+			v["email"] = *email
+		}
+
+		if name := item.Name; name != nil {
+			v["name"] = *name
+		}
+
+		if phone := item.Phone; phone != nil {
+			v["phone"] = *phone
+		}
+
+		results = append(results, v)
+	}
+
+	return results
+}
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -293,6 +294,106 @@ func TestAccAzureRMKeyVault_justCert(t *testing.T) {
 	})
 }
 
+func TestValidateKeyVaultSoftDeleteAndPurgeProtection(t *testing.T) {
+	cases := []struct {
+		OldSoftDelete      bool
+		NewSoftDelete      bool
+		OldPurgeProtection bool
+		NewPurgeProtection bool
+		ExpectError        bool
+	}{
+		{
+			ExpectError: false,
+		},
+		{
+			NewSoftDelete: true,
+			ExpectError:   false,
+		},
+		{
+			NewSoftDelete:      true,
+			NewPurgeProtection: true,
+			ExpectError:        false,
+		},
+		{
+			NewPurgeProtection: true,
+			ExpectError:        true,
+		},
+		{
+			OldSoftDelete: true,
+			NewSoftDelete: false,
+			ExpectError:   true,
+		},
+		{
+			OldSoftDelete:      true,
+			NewSoftDelete:      true,
+			OldPurgeProtection: true,
+			NewPurgeProtection: false,
+			ExpectError:        true,
+		},
+		{
+			OldSoftDelete:      true,
+			NewSoftDelete:      true,
+			OldPurgeProtection: false,
+			NewPurgeProtection: true,
+			ExpectError:        false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %+v", tc)
+
+		err := validateKeyVaultSoftDeleteAndPurgeProtection(tc.OldSoftDelete, tc.NewSoftDelete, tc.OldPurgeProtection, tc.NewPurgeProtection)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
+// Key Vaults with Purge Protection enabled can't be purged, so deleting one leaves it (and its name) in a
+// soft-deleted state until Azure removes it 90 days later - as such this test is opt-in
+func TestAccAzureRMKeyVault_purgeProtection(t *testing.T) {
+	if os.Getenv("ARM_KEYVAULT_TEST_PURGE_PROTECTION") == "" {
+		t.Skip("Skipping since `ARM_KEYVAULT_TEST_PURGE_PROTECTION` isn't set - this test leaves a soft-deleted Key Vault behind for 90 days")
+		return
+	}
+
+	resourceName := "azurerm_key_vault.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVault_purgeProtection(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).keyVaultClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -673,6 +774,44 @@ resource "azurerm_key_vault" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMKeyVault_purgeProtection(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.client_id}"
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "set",
+    ]
+  }
+
+  soft_delete_enabled      = true
+  purge_protection_enabled = true
+}
+`, rInt, location, rInt)
+}
+
 func testAccAzureRMKeyVault_accessPolicyUpperLimit(rInt int, location string, rs string) string {
 
 	var storageAccountConfigs string
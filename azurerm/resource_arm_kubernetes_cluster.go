@@ -139,10 +139,11 @@ func resourceArmKubernetesCluster() *schema.Resource {
 						},
 
 						"vnet_subnet_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"os_type": {
@@ -232,9 +233,10 @@ func resourceArmKubernetesCluster() *schema.Resource {
 										Required: true,
 									},
 									"log_analytics_workspace_id": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     azure.ValidateResourceID,
+										DiffSuppressFunc: suppress.ResourceIDDifference,
 									},
 								},
 							},
@@ -529,7 +531,7 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -584,16 +586,17 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
-		return fmt.Errorf("Error creating/updating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error creating/updating Managed Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+	description := fmt.Sprintf("Managed Kubernetes Cluster %q (Resource Group %q) to be created/updated", name, resGroup)
+	if err = azure.WaitForCompletionWithProgress(ctx, &future.Future, client.Client, description); err != nil {
+		return fmt.Errorf("Error waiting for completion of Managed Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -624,12 +627,12 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	profile, err := client.GetAccessProfile(ctx, resGroup, name, "clusterUser")
 	if err != nil {
-		return fmt.Errorf("Error retrieving Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -678,12 +681,12 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		if props.AadProfile != nil {
 			adminProfile, err := client.GetAccessProfile(ctx, resGroup, name, "clusterAdmin")
 			if err != nil {
-				return fmt.Errorf("Error retrieving Admin Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+				return fmt.Errorf("Error retrieving Admin Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 
 			adminKubeConfigRaw, adminKubeConfig := flattenKubernetesClusterAccessProfile(adminProfile)
-			d.Set("kube_admin_config_raw", adminKubeConfigRaw)
-			if err := d.Set("kube_admin_config", adminKubeConfig); err != nil {
+			d.Set("kube_admin_config_raw", sensitiveValue(meta, adminKubeConfigRaw))
+			if err := d.Set("kube_admin_config", sensitiveValuesInList(meta, adminKubeConfig, "password", "client_key")); err != nil {
 				return fmt.Errorf("Error setting `kube_admin_config`: %+v", err)
 			}
 		} else {
@@ -693,8 +696,8 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 	}
 
 	kubeConfigRaw, kubeConfig := flattenKubernetesClusterAccessProfile(profile)
-	d.Set("kube_config_raw", kubeConfigRaw)
-	if err := d.Set("kube_config", kubeConfig); err != nil {
+	d.Set("kube_config_raw", sensitiveValue(meta, kubeConfigRaw))
+	if err := d.Set("kube_config", sensitiveValuesInList(meta, kubeConfig, "password", "client_key")); err != nil {
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
 	}
 
@@ -716,11 +719,12 @@ func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error deleting Managed Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the deletion of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+	description := fmt.Sprintf("Managed Kubernetes Cluster %q (Resource Group %q) to be deleted", name, resGroup)
+	if err := azure.WaitForCompletionWithProgress(ctx, &future.Future, client.Client, description); err != nil {
+		return fmt.Errorf("Error waiting for the deletion of Managed Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	return nil
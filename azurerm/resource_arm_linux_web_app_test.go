@@ -0,0 +1,356 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMLinuxWebApp_basic(t *testing.T) {
+	resourceName := "azurerm_linux_web_app.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMLinuxWebApp_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLinuxWebAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLinuxWebAppExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "default_hostname"),
+					resource.TestCheckResourceAttrSet(resourceName, "outbound_ip_addresses"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLinuxWebApp_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_linux_web_app.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLinuxWebAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLinuxWebApp_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLinuxWebAppExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMLinuxWebApp_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_linux_web_app"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLinuxWebApp_applicationStack(t *testing.T) {
+	resourceName := "azurerm_linux_web_app.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLinuxWebAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLinuxWebApp_docker(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLinuxWebAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.application_stack.0.docker_image", "nginx"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.linux_fx_version", "DOCKER|nginx:latest"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMLinuxWebApp_node(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLinuxWebAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.application_stack.0.node_version", "10.14"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.linux_fx_version", "NODE|10.14"),
+				),
+			},
+			{
+				Config: testAccAzureRMLinuxWebApp_java(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLinuxWebAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.linux_fx_version", "TOMCAT|9.0-java11"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLinuxWebApp_autoHeal(t *testing.T) {
+	resourceName := "azurerm_linux_web_app.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMLinuxWebApp_autoHeal(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLinuxWebAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLinuxWebAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal_rules.0.action.0.action_type", "Recycle"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal_rules.0.trigger.0.status_code.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLinuxWebAppDestroy(s *terraform.State) error {
+	return testCheckAzureRMWebAppDestroy(s, "azurerm_linux_web_app")
+}
+
+func testCheckAzureRMLinuxWebAppExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMWebAppExists(resourceName)
+}
+
+func testCheckAzureRMWebAppDestroy(s *terraform.State, resourceType string) error {
+	client := testAccProvider.Meta().(*ArmClient).appServicesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Web App %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMWebAppExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Web App: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Web App %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appServicesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLinuxWebApp_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  kind                = "Linux"
+  reserved            = true
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMLinuxWebApp_basic(rInt int, location string) string {
+	template := testAccAzureRMLinuxWebApp_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  service_plan_id     = "${azurerm_app_service_plan.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMLinuxWebApp_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMLinuxWebApp_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_web_app" "import" {
+  name                = "${azurerm_linux_web_app.test.name}"
+  location            = "${azurerm_linux_web_app.test.location}"
+  resource_group_name = "${azurerm_linux_web_app.test.resource_group_name}"
+  service_plan_id     = "${azurerm_linux_web_app.test.service_plan_id}"
+}
+`, template)
+}
+
+func testAccAzureRMLinuxWebApp_docker(rInt int, location string) string {
+	template := testAccAzureRMLinuxWebApp_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  service_plan_id     = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    always_on = true
+
+    application_stack {
+      docker_image     = "nginx"
+      docker_image_tag = "latest"
+    }
+  }
+
+  app_settings = {
+    "WEBSITES_ENABLE_APP_SERVICE_STORAGE" = "false"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMLinuxWebApp_node(rInt int, location string) string {
+	template := testAccAzureRMLinuxWebApp_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  service_plan_id     = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    always_on = true
+
+    application_stack {
+      node_version = "10.14"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMLinuxWebApp_java(rInt int, location string) string {
+	template := testAccAzureRMLinuxWebApp_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  service_plan_id     = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    always_on = true
+
+    application_stack {
+      java_server         = "TOMCAT"
+      java_server_version = "9.0"
+      java_version        = "11"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMLinuxWebApp_autoHeal(rInt int, location string) string {
+	template := testAccAzureRMLinuxWebApp_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  service_plan_id     = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    auto_heal_enabled = true
+
+    auto_heal_rules {
+      action {
+        action_type                    = "Recycle"
+        minimum_process_execution_time = "00:01:00"
+      }
+
+      trigger {
+        status_code {
+          status_code = 500
+          count       = 10
+          interval    = "00:05:00"
+        }
+      }
+    }
+  }
+}
+`, template, rInt)
+}
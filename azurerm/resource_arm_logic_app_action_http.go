@@ -5,11 +5,10 @@ import (
 	"log"
 	"strings"
 
-	"net/http"
-
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
 func resourceArmLogicAppActionHTTP() *schema.Resource {
@@ -30,22 +29,17 @@ func resourceArmLogicAppActionHTTP() *schema.Resource {
 			},
 
 			"logic_app_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"method": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					string(http.MethodDelete),
-					string(http.MethodGet),
-					string(http.MethodPatch),
-					string(http.MethodPost),
-					string(http.MethodPut),
-				}, false),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.HTTPMethod(false),
 			},
 
 			"uri": {
@@ -59,8 +53,9 @@ func resourceArmLogicAppActionHTTP() *schema.Resource {
 			},
 
 			"headers": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validate.HTTPHeaders,
 			},
 		},
 	}
@@ -0,0 +1,161 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogicAppIntegrationAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppIntegrationAccountCreateUpdate,
+		Read:   resourceArmLogicAppIntegrationAccountRead,
+		Update: resourceArmLogicAppIntegrationAccountCreateUpdate,
+		Delete: resourceArmLogicAppIntegrationAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(logic.IntegrationAccountSkuNameFree),
+					string(logic.IntegrationAccountSkuNameStandard),
+				}, false),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmLogicAppIntegrationAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Logic App Integration Account creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Logic App Integration Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_logic_app_integration_account", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	account := logic.IntegrationAccount{
+		Location:   utils.String(location),
+		Properties: map[string]interface{}{},
+		Sku: &logic.IntegrationAccountSku{
+			Name: logic.IntegrationAccountSkuName(d.Get("sku_name").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, account); err != nil {
+		return fmt.Errorf("[ERROR] Error creating/updating Logic App Integration Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error making Read request on Logic App Integration Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("[ERROR] Cannot read Logic App Integration Account %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogicAppIntegrationAccountRead(d, meta)
+}
+
+func resourceArmLogicAppIntegrationAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["integrationAccounts"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Logic App Integration Account %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error making Read request on Logic App Integration Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku_name", string(sku.Name))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmLogicAppIntegrationAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["integrationAccounts"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error issuing delete request for Logic App Integration Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogicAppIntegrationAccountMap() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppIntegrationAccountMapCreateUpdate,
+		Read:   resourceArmLogicAppIntegrationAccountMapRead,
+		Update: resourceArmLogicAppIntegrationAccountMapCreateUpdate,
+		Delete: resourceArmLogicAppIntegrationAccountMapDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"integration_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"map_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(logic.MapTypeXslt),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(logic.MapTypeXslt),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppIntegrationAccountMapCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountMapsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Logic App Integration Account Map creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("integration_account_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Logic App Integration Account Map %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_logic_app_integration_account_map", *existing.ID)
+		}
+	}
+
+	properties := logic.IntegrationAccountMap{
+		IntegrationAccountMapProperties: &logic.IntegrationAccountMapProperties{
+			MapType:     logic.MapType(d.Get("map_type").(string)),
+			Content:     utils.String(d.Get("content").(string)),
+			ContentType: utils.String("application/xml"),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, accountName, name, properties); err != nil {
+		return fmt.Errorf("[ERROR] Error creating/updating Logic App Integration Account Map %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error making Read request on Logic App Integration Account Map %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("[ERROR] Cannot read Logic App Integration Account Map %q (Integration Account %q / Resource Group %q) ID", name, accountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogicAppIntegrationAccountMapRead(d, meta)
+}
+
+func resourceArmLogicAppIntegrationAccountMapRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountMapsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["integrationAccounts"]
+	name := id.Path["maps"]
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Logic App Integration Account Map %q (Integration Account %q / Resource Group %q) was not found - removing from state", name, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error making Read request on Logic App Integration Account Map %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("integration_account_name", accountName)
+
+	if props := resp.IntegrationAccountMapProperties; props != nil {
+		// the API doesn't return the `content` - so we intentionally don't set it
+		d.Set("map_type", string(props.MapType))
+	}
+
+	return nil
+}
+
+func resourceArmLogicAppIntegrationAccountMapDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountMapsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["integrationAccounts"]
+	name := id.Path["maps"]
+
+	resp, err := client.Delete(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error issuing delete request for Logic App Integration Account Map %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
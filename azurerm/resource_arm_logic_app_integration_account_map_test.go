@@ -0,0 +1,117 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMLogicAppIntegrationAccountMap_basic(t *testing.T) {
+	resourceName := "azurerm_logic_app_integration_account_map.test"
+	ri := tf.AccRandTimeInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppIntegrationAccountMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppIntegrationAccountMap_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppIntegrationAccountMapExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "map_type", "Xslt"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogicAppIntegrationAccountMapExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["integration_account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Logic App Integration Account Map: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).logicIntegrationAccountMapsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on logicIntegrationAccountMapsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Logic App Integration Account Map %q (Integration Account %q / resource group %q) does not exist", name, accountName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLogicAppIntegrationAccountMapDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).logicIntegrationAccountMapsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_logic_app_integration_account_map" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["integration_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Logic App Integration Account Map still exists: \n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMLogicAppIntegrationAccountMap_basic(rInt int, location string) string {
+	template := testAccAzureRMLogicAppIntegrationAccount_basic(rInt, location, "Free")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_integration_account_map" "test" {
+  name                     = "acctestmap-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  integration_account_name = "${azurerm_logic_app_integration_account.test.name}"
+
+  content = <<XML
+<?xml version="1.0" encoding="utf-8"?>
+<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:template match="/">
+    <Output>
+      <xsl:value-of select="/Input/Value" />
+    </Output>
+  </xsl:template>
+</xsl:stylesheet>
+XML
+}
+`, template, rInt)
+}
@@ -0,0 +1,214 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogicAppIntegrationAccountPartner() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppIntegrationAccountPartnerCreateUpdate,
+		Read:   resourceArmLogicAppIntegrationAccountPartnerRead,
+		Update: resourceArmLogicAppIntegrationAccountPartnerCreateUpdate,
+		Delete: resourceArmLogicAppIntegrationAccountPartnerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"integration_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"business_identity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"qualifier": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppIntegrationAccountPartnerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountPartnersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Logic App Integration Account Partner creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("integration_account_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Logic App Integration Account Partner %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_logic_app_integration_account_partner", *existing.ID)
+		}
+	}
+
+	identities := expandLogicAppIntegrationAccountPartnerBusinessIdentities(d.Get("business_identity").([]interface{}))
+
+	properties := logic.IntegrationAccountPartner{
+		IntegrationAccountPartnerProperties: &logic.IntegrationAccountPartnerProperties{
+			PartnerType: logic.PartnerTypeB2B,
+			Content: &logic.PartnerContent{
+				B2b: &logic.B2BPartnerContent{
+					BusinessIdentities: identities,
+				},
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, accountName, name, properties); err != nil {
+		return fmt.Errorf("[ERROR] Error creating/updating Logic App Integration Account Partner %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error making Read request on Logic App Integration Account Partner %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("[ERROR] Cannot read Logic App Integration Account Partner %q (Integration Account %q / Resource Group %q) ID", name, accountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogicAppIntegrationAccountPartnerRead(d, meta)
+}
+
+func resourceArmLogicAppIntegrationAccountPartnerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountPartnersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["integrationAccounts"]
+	name := id.Path["partners"]
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Logic App Integration Account Partner %q (Integration Account %q / Resource Group %q) was not found - removing from state", name, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error making Read request on Logic App Integration Account Partner %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("integration_account_name", accountName)
+
+	if props := resp.IntegrationAccountPartnerProperties; props != nil {
+		if content := props.Content; content != nil && content.B2b != nil {
+			if err := d.Set("business_identity", flattenLogicAppIntegrationAccountPartnerBusinessIdentities(content.B2b.BusinessIdentities)); err != nil {
+				return fmt.Errorf("Error setting `business_identity`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmLogicAppIntegrationAccountPartnerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountPartnersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["integrationAccounts"]
+	name := id.Path["partners"]
+
+	resp, err := client.Delete(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error issuing delete request for Logic App Integration Account Partner %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
+func expandLogicAppIntegrationAccountPartnerBusinessIdentities(input []interface{}) *[]logic.BusinessIdentity {
+	identities := make([]logic.BusinessIdentity, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		identities = append(identities, logic.BusinessIdentity{
+			Qualifier: utils.String(raw["qualifier"].(string)),
+			Value:     utils.String(raw["value"].(string)),
+		})
+	}
+
+	return &identities
+}
+
+func flattenLogicAppIntegrationAccountPartnerBusinessIdentities(input *[]logic.BusinessIdentity) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		identity := make(map[string]interface{})
+
+		if v.Qualifier != nil {
+			identity["qualifier"] = *v.Qualifier
+		}
+		if v.Value != nil {
+			identity["value"] = *v.Value
+		}
+
+		results = append(results, identity)
+	}
+
+	return results
+}
@@ -0,0 +1,166 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMLogicAppIntegrationAccountPartner_basic(t *testing.T) {
+	resourceName := "azurerm_logic_app_integration_account_partner.test"
+	ri := tf.AccRandTimeInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppIntegrationAccountPartnerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppIntegrationAccountPartner_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppIntegrationAccountPartnerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "business_identity.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogicAppIntegrationAccountPartner_update(t *testing.T) {
+	resourceName := "azurerm_logic_app_integration_account_partner.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppIntegrationAccountPartnerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppIntegrationAccountPartner_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppIntegrationAccountPartnerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "business_identity.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMLogicAppIntegrationAccountPartner_multipleIdentities(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppIntegrationAccountPartnerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "business_identity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "business_identity.1.qualifier", "ZZZ"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogicAppIntegrationAccountPartnerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["integration_account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Logic App Integration Account Partner: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).logicIntegrationAccountPartnersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on logicIntegrationAccountPartnersClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Logic App Integration Account Partner %q (Integration Account %q / resource group %q) does not exist", name, accountName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLogicAppIntegrationAccountPartnerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).logicIntegrationAccountPartnersClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_logic_app_integration_account_partner" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["integration_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Logic App Integration Account Partner still exists: \n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMLogicAppIntegrationAccountPartner_basic(rInt int, location string) string {
+	template := testAccAzureRMLogicAppIntegrationAccount_basic(rInt, location, "Free")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_integration_account_partner" "test" {
+  name                     = "acctestpartner-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  integration_account_name = "${azurerm_logic_app_integration_account.test.name}"
+
+  business_identity {
+    qualifier = "AS2Identity"
+    value     = "Contoso"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMLogicAppIntegrationAccountPartner_multipleIdentities(rInt int, location string) string {
+	template := testAccAzureRMLogicAppIntegrationAccount_basic(rInt, location, "Free")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_integration_account_partner" "test" {
+  name                     = "acctestpartner-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  integration_account_name = "${azurerm_logic_app_integration_account.test.name}"
+
+  business_identity {
+    qualifier = "AS2Identity"
+    value     = "Contoso"
+  }
+
+  business_identity {
+    qualifier = "ZZZ"
+    value     = "123456789"
+  }
+}
+`, template, rInt)
+}
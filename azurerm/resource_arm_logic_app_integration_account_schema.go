@@ -0,0 +1,164 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogicAppIntegrationAccountSchema() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppIntegrationAccountSchemaCreateUpdate,
+		Read:   resourceArmLogicAppIntegrationAccountSchemaRead,
+		Update: resourceArmLogicAppIntegrationAccountSchemaCreateUpdate,
+		Delete: resourceArmLogicAppIntegrationAccountSchemaDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"integration_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"file_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppIntegrationAccountSchemaCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountSchemasClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Logic App Integration Account Schema creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("integration_account_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Logic App Integration Account Schema %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_logic_app_integration_account_schema", *existing.ID)
+		}
+	}
+
+	properties := logic.IntegrationAccountSchema{
+		IntegrationAccountSchemaProperties: &logic.IntegrationAccountSchemaProperties{
+			SchemaType:  logic.SchemaTypeXML,
+			Content:     utils.String(d.Get("content").(string)),
+			ContentType: utils.String("application/xml"),
+		},
+	}
+
+	if v, ok := d.GetOk("file_name"); ok {
+		properties.IntegrationAccountSchemaProperties.FileName = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, accountName, name, properties); err != nil {
+		return fmt.Errorf("[ERROR] Error creating/updating Logic App Integration Account Schema %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error making Read request on Logic App Integration Account Schema %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("[ERROR] Cannot read Logic App Integration Account Schema %q (Integration Account %q / Resource Group %q) ID", name, accountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogicAppIntegrationAccountSchemaRead(d, meta)
+}
+
+func resourceArmLogicAppIntegrationAccountSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountSchemasClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["integrationAccounts"]
+	name := id.Path["schemas"]
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Logic App Integration Account Schema %q (Integration Account %q / Resource Group %q) was not found - removing from state", name, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error making Read request on Logic App Integration Account Schema %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("integration_account_name", accountName)
+
+	if props := resp.IntegrationAccountSchemaProperties; props != nil {
+		// the API doesn't return the `content` - so we intentionally don't set it
+		d.Set("file_name", props.FileName)
+	}
+
+	return nil
+}
+
+func resourceArmLogicAppIntegrationAccountSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicIntegrationAccountSchemasClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["integrationAccounts"]
+	name := id.Path["schemas"]
+
+	resp, err := client.Delete(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error issuing delete request for Logic App Integration Account Schema %q (Integration Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
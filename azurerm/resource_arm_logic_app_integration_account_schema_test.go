@@ -0,0 +1,162 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMLogicAppIntegrationAccountSchema_basic(t *testing.T) {
+	resourceName := "azurerm_logic_app_integration_account_schema.test"
+	ri := tf.AccRandTimeInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppIntegrationAccountSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppIntegrationAccountSchema_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppIntegrationAccountSchemaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "file_name", "order.xsd"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogicAppIntegrationAccountSchema_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_logic_app_integration_account_schema.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppIntegrationAccountSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppIntegrationAccountSchema_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppIntegrationAccountSchemaExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMLogicAppIntegrationAccountSchema_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_logic_app_integration_account_schema"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogicAppIntegrationAccountSchemaExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["integration_account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Logic App Integration Account Schema: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).logicIntegrationAccountSchemasClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on logicIntegrationAccountSchemasClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Logic App Integration Account Schema %q (Integration Account %q / resource group %q) does not exist", name, accountName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLogicAppIntegrationAccountSchemaDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).logicIntegrationAccountSchemasClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_logic_app_integration_account_schema" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["integration_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Logic App Integration Account Schema still exists: \n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMLogicAppIntegrationAccountSchema_basic(rInt int, location string) string {
+	template := testAccAzureRMLogicAppIntegrationAccount_basic(rInt, location, "Free")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_integration_account_schema" "test" {
+  name                     = "acctestschema-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  integration_account_name = "${azurerm_logic_app_integration_account.test.name}"
+  file_name                = "order.xsd"
+
+  content = <<XML
+<?xml version="1.0" encoding="utf-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/order" elementFormDefault="qualified">
+  <xs:element name="Order">
+    <xs:complexType>
+      <xs:sequence>
+        <xs:element name="Id" type="xs:string" />
+      </xs:sequence>
+    </xs:complexType>
+  </xs:element>
+</xs:schema>
+XML
+}
+`, template, rInt)
+}
+
+func testAccAzureRMLogicAppIntegrationAccountSchema_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMLogicAppIntegrationAccountSchema_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_integration_account_schema" "import" {
+  name                     = "${azurerm_logic_app_integration_account_schema.test.name}"
+  resource_group_name      = "${azurerm_logic_app_integration_account_schema.test.resource_group_name}"
+  integration_account_name = "${azurerm_logic_app_integration_account_schema.test.integration_account_name}"
+  content                  = "${azurerm_logic_app_integration_account_schema.test.content}"
+}
+`, template)
+}
@@ -0,0 +1,234 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmManagedDiskSasToken() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagedDiskSasTokenCreate,
+		Read:   resourceArmManagedDiskSasTokenRead,
+		Delete: resourceArmManagedDiskSasTokenDelete,
+
+		CustomizeDiff: resourceArmManagedDiskSasTokenCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"managed_disk_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+				ConflictsWith:    []string{"snapshot_id"},
+			},
+
+			"snapshot_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+				ConflictsWith:    []string{"managed_disk_id"},
+			},
+
+			"duration_in_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(30, 4294967),
+			},
+
+			"access_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(compute.Read),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Read),
+				}, false),
+			},
+
+			"sas_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmManagedDiskSasTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	diskClient := meta.(*ArmClient).diskClient
+	snapshotsClient := meta.(*ArmClient).snapshotsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceId := d.Get("managed_disk_id").(string)
+	if resourceId == "" {
+		resourceId = d.Get("snapshot_id").(string)
+	}
+	if resourceId == "" {
+		return fmt.Errorf("one of `managed_disk_id` or `snapshot_id` must be set")
+	}
+
+	id, err := parseAzureResourceID(resourceId)
+	if err != nil {
+		return err
+	}
+
+	grantAccessData := compute.GrantAccessData{
+		Access:            compute.AccessLevel(d.Get("access_level").(string)),
+		DurationInSeconds: utils.Int32(int32(d.Get("duration_in_seconds").(int))),
+	}
+
+	var accessUri compute.AccessURI
+	if name, ok := id.Path["disks"]; ok {
+		log.Printf("[DEBUG] Granting access to Managed Disk %q (Resource Group %q)", name, id.ResourceGroup)
+		future, err := diskClient.GrantAccess(ctx, id.ResourceGroup, name, grantAccessData)
+		if err != nil {
+			return fmt.Errorf("Error granting access to Managed Disk %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, diskClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for access to be granted to Managed Disk %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+
+		accessUri, err = future.Result(diskClient)
+		if err != nil {
+			return fmt.Errorf("Error retrieving SAS URL for Managed Disk %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+	} else if name, ok := id.Path["snapshots"]; ok {
+		log.Printf("[DEBUG] Granting access to Snapshot %q (Resource Group %q)", name, id.ResourceGroup)
+		future, err := snapshotsClient.GrantAccess(ctx, id.ResourceGroup, name, grantAccessData)
+		if err != nil {
+			return fmt.Errorf("Error granting access to Snapshot %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, snapshotsClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for access to be granted to Snapshot %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+
+		accessUri, err = future.Result(snapshotsClient)
+		if err != nil {
+			return fmt.Errorf("Error retrieving SAS URL for Snapshot %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+	} else {
+		return fmt.Errorf("Expected %q to be the ID of a Managed Disk or a Snapshot", resourceId)
+	}
+
+	if accessUri.AccessSAS == nil {
+		return fmt.Errorf("Error retrieving SAS URL for %q: `accessSAS` was nil", resourceId)
+	}
+
+	// a Managed Disk/Snapshot only has a single active SAS URL at any time, so the ID of the
+	// Managed Disk/Snapshot is sufficient to identify this token
+	d.SetId(resourceId)
+	d.Set("sas_url", accessUri.AccessSAS)
+
+	return resourceArmManagedDiskSasTokenRead(d, meta)
+}
+
+func resourceArmManagedDiskSasTokenRead(d *schema.ResourceData, meta interface{}) error {
+	diskClient := meta.(*ArmClient).diskClient
+	snapshotsClient := meta.(*ArmClient).snapshotsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the SAS URL can't be retrieved after it's been granted, so all we can do is check the
+	// Managed Disk/Snapshot still exists - the configured `managed_disk_id`/`snapshot_id` is kept
+	// as-is, since these are ForceNew and the API may return them with different casing
+	if name, ok := id.Path["disks"]; ok {
+		resp, err := diskClient.Get(ctx, id.ResourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Managed Disk %q was not found in Resource Group %q - removing SAS Token from state", name, id.ResourceGroup)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error retrieving Managed Disk %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+	} else if name, ok := id.Path["snapshots"]; ok {
+		resp, err := snapshotsClient.Get(ctx, id.ResourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Snapshot %q was not found in Resource Group %q - removing SAS Token from state", name, id.ResourceGroup)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error retrieving Snapshot %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+	} else {
+		return fmt.Errorf("Expected %q to be the ID of a Managed Disk or a Snapshot", d.Id())
+	}
+
+	return nil
+}
+
+func resourceArmManagedDiskSasTokenCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	// values which aren't known until apply (e.g. the ID of a Managed Disk created in the same run) count as set
+	managedDiskSet := d.Get("managed_disk_id").(string) != "" || !d.NewValueKnown("managed_disk_id")
+	snapshotSet := d.Get("snapshot_id").(string) != "" || !d.NewValueKnown("snapshot_id")
+
+	if managedDiskSet == snapshotSet {
+		return fmt.Errorf("exactly one of `managed_disk_id` or `snapshot_id` must be set")
+	}
+
+	return nil
+}
+
+func resourceArmManagedDiskSasTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	diskClient := meta.(*ArmClient).diskClient
+	snapshotsClient := meta.(*ArmClient).snapshotsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if name, ok := id.Path["disks"]; ok {
+		future, err := diskClient.RevokeAccess(ctx, id.ResourceGroup, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				return nil
+			}
+			return fmt.Errorf("Error revoking access to Managed Disk %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, diskClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for access to be revoked for Managed Disk %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+
+		return nil
+	}
+
+	if name, ok := id.Path["snapshots"]; ok {
+		future, err := snapshotsClient.RevokeAccess(ctx, id.ResourceGroup, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				return nil
+			}
+			return fmt.Errorf("Error revoking access to Snapshot %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, snapshotsClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for access to be revoked for Snapshot %q (Resource Group %q): %s", name, id.ResourceGroup, azure.FormatError(err))
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("Expected %q to be the ID of a Managed Disk or a Snapshot", d.Id())
+}
@@ -0,0 +1,109 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMManagedDiskSasToken_disk(t *testing.T) {
+	resourceName := "azurerm_managed_disk_sas_token.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	var d compute.Disk
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedDiskSasToken_disk(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists("azurerm_managed_disk.test", &d, true),
+					resource.TestCheckResourceAttrSet(resourceName, "sas_url"),
+					resource.TestCheckResourceAttr(resourceName, "access_level", "Read"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedDiskSasToken_snapshot(t *testing.T) {
+	resourceName := "azurerm_managed_disk_sas_token.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedDiskSasToken_snapshot(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSnapshotExists("azurerm_snapshot.test"),
+					resource.TestCheckResourceAttrSet(resourceName, "sas_url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedDiskSasToken_noSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMManagedDiskSasToken_noSource(),
+				ExpectError: regexp.MustCompile("exactly one of `managed_disk_id` or `snapshot_id` must be set"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMManagedDiskSasToken_disk(rInt int, location string) string {
+	template := testAccAzureRMManagedDisk_empty(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk_sas_token" "test" {
+  managed_disk_id     = "${azurerm_managed_disk.test.id}"
+  duration_in_seconds = 300
+}
+`, template)
+}
+
+func testAccAzureRMManagedDiskSasToken_snapshot(rInt int, location string) string {
+	template := testAccAzureRMManagedDisk_empty(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_snapshot" "test" {
+  name                = "acctestss_%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  create_option       = "Copy"
+  source_uri          = "${azurerm_managed_disk.test.id}"
+}
+
+resource "azurerm_managed_disk_sas_token" "test" {
+  snapshot_id         = "${azurerm_snapshot.test.id}"
+  duration_in_seconds = 300
+  access_level        = "Read"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMManagedDiskSasToken_noSource() string {
+	return `
+resource "azurerm_managed_disk_sas_token" "test" {
+  duration_in_seconds = 300
+}
+`
+}
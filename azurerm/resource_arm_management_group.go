@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -76,7 +77,7 @@ func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interfa
 		existing, err := client.Get(ctx, groupId, "children", &recurse, "", managementGroupCacheControl)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Management Group %q: %s", groupId, err)
+				return fmt.Errorf("Error checking for presence of existing Management Group %q: %s", groupId, azure.FormatError(err))
 			}
 		}
 
@@ -105,16 +106,16 @@ func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interfa
 
 	future, err := client.CreateOrUpdate(ctx, groupId, properties, managementGroupCacheControl)
 	if err != nil {
-		return fmt.Errorf("Error creating Management Group %q: %+v", groupId, err)
+		return fmt.Errorf("Error creating Management Group %q: %s", groupId, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of Management Group %q: %+v", groupId, err)
+		return fmt.Errorf("Error waiting for creation of Management Group %q: %s", groupId, azure.FormatError(err))
 	}
 
 	resp, err := client.Get(ctx, groupId, "children", &recurse, "", managementGroupCacheControl)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Management Group %q: %+v", groupId, err)
+		return fmt.Errorf("Error retrieving Management Group %q: %s", groupId, azure.FormatError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -173,7 +174,7 @@ func resourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Management Group %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error reading Management Group %q: %s", d.Id(), azure.FormatError(err))
 	}
 
 	d.Set("group_id", id.groupId)
@@ -220,7 +221,7 @@ func resourceArmManagementGroupDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Management Group %q: %+v", id.groupId, err)
+		return fmt.Errorf("Error retrieving Management Group %q: %s", id.groupId, azure.FormatError(err))
 	}
 
 	// before deleting a management group, return any subscriptions to the root management group
@@ -246,12 +247,12 @@ func resourceArmManagementGroupDelete(d *schema.ResourceData, meta interface{})
 
 	resp, err := client.Delete(ctx, id.groupId, managementGroupCacheControl)
 	if err != nil {
-		return fmt.Errorf("Error deleting Management Group %q: %+v", id.groupId, err)
+		return fmt.Errorf("Error deleting Management Group %q: %s", id.groupId, azure.FormatError(err))
 	}
 
 	err = resp.WaitForCompletionRef(ctx, client.Client)
 	if err != nil {
-		return fmt.Errorf("Error waiting for the deletion of Management Group %q: %+v", id.groupId, err)
+		return fmt.Errorf("Error waiting for the deletion of Management Group %q: %s", id.groupId, azure.FormatError(err))
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -68,7 +69,7 @@ func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interfac
 		existing, err := client.GetByScope(ctx, scope, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Management Lock %q (Scope %q): %s", name, scope, err)
+				return fmt.Errorf("Error checking for presence of existing Management Lock %q (Scope %q): %s", name, scope, azure.FormatError(err))
 			}
 		}
 
@@ -119,7 +120,7 @@ func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Management Lock %q (Scope %q): %+v", id.Name, id.Scope, err)
+		return fmt.Errorf("Error making Read request on AzureRM Management Lock %q (Scope %q): %s", id.Name, id.Scope, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -148,7 +149,7 @@ func resourceArmManagementLockDelete(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error issuing AzureRM delete request for Management Lock %q (Scope %q): %+v", id.Name, id.Scope, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Management Lock %q (Scope %q): %s", id.Name, id.Scope, azure.FormatError(err))
 	}
 
 	return nil
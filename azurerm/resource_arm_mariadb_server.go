@@ -13,6 +13,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/mariadb/mgmt/2018-06-01-preview/mariadb"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -162,6 +163,8 @@ func resourceArmMariaDbServer() *schema.Resource {
 				}, false),
 			},
 
+			"threat_detection_policy": azure.SchemaDatabaseServerThreatDetectionPolicy(),
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -185,7 +188,7 @@ func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing MariaDB Server %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing MariaDB Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -272,16 +275,16 @@ func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error creating MariaDB Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating MariaDB Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of MariaDB Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for creation of MariaDB Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving MariaDB Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving MariaDB Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -290,6 +293,12 @@ func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("threat_detection_policy") {
+		if err := updateMariaDbServerSecurityAlertPolicy(d, meta, resourceGroup, name); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmMariaDbServerRead(d, meta)
 }
 
@@ -312,7 +321,7 @@ func resourceArmMariaDbServerRead(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Azure MariaDB Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Azure MariaDB Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -340,6 +349,15 @@ func resourceArmMariaDbServerRead(d *schema.ResourceData, meta interface{}) erro
 
 	flattenAndSetTags(d, resp.Tags)
 
+	// Threat Detection isn't supported for all SKU's, so we only log any error when retrieving the Policy
+	securityAlertPoliciesClient := meta.(*ArmClient).mariadbServerSecurityAlertPoliciesClient
+	policy, err := securityAlertPoliciesClient.Get(ctx, resourceGroup, name)
+	if err != nil {
+		log.Printf("[WARN] Error retrieving Threat Detection Policy for MariaDB Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	} else if err := d.Set("threat_detection_policy", flattenMariaDbServerSecurityAlertPolicy(d, policy.SecurityAlertPolicyProperties)); err != nil {
+		return fmt.Errorf("Error setting `threat_detection_policy`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -360,7 +378,7 @@ func resourceArmMariaDbServerDelete(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting MariaDB Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error deleting MariaDB Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
@@ -368,7 +386,7 @@ func resourceArmMariaDbServerDelete(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("Error waiting for deletion of MariaDB Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for deletion of MariaDB Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	return nil
@@ -449,3 +467,86 @@ func flattenMariaDbStorageProfile(storage *mariadb.StorageProfile) []interface{}
 
 	return []interface{}{values}
 }
+
+func updateMariaDbServerSecurityAlertPolicy(d *schema.ResourceData, meta interface{}, resourceGroup, name string) error {
+	client := meta.(*ArmClient).mariadbServerSecurityAlertPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] Updating Threat Detection Policy for MariaDB Server %q (Resource Group %q)", name, resourceGroup)
+
+	policy := expandMariaDbServerSecurityAlertPolicy(d)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, policy)
+	if err != nil {
+		return fmt.Errorf("Error updating Threat Detection Policy for MariaDB Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Threat Detection Policy for MariaDB Server %q (Resource Group %q) to finish updating: %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
+func expandMariaDbServerSecurityAlertPolicy(d *schema.ResourceData) mariadb.ServerSecurityAlertPolicy {
+	policy := azure.ExpandDatabaseServerThreatDetectionPolicy(d.Get("threat_detection_policy").([]interface{}))
+
+	state := mariadb.ServerSecurityAlertPolicyStateDisabled
+	if policy.Enabled {
+		state = mariadb.ServerSecurityAlertPolicyStateEnabled
+	}
+
+	properties := mariadb.SecurityAlertPolicyProperties{
+		State:              state,
+		DisabledAlerts:     &policy.DisabledAlerts,
+		EmailAccountAdmins: utils.Bool(policy.EmailAccountAdmins),
+		EmailAddresses:     &policy.EmailAddresses,
+		RetentionDays:      utils.Int32(int32(policy.RetentionDays)),
+	}
+
+	if policy.StorageAccountAccessKey != "" {
+		properties.StorageAccountAccessKey = utils.String(policy.StorageAccountAccessKey)
+	}
+
+	if policy.StorageEndpoint != "" {
+		properties.StorageEndpoint = utils.String(policy.StorageEndpoint)
+	}
+
+	return mariadb.ServerSecurityAlertPolicy{
+		SecurityAlertPolicyProperties: &properties,
+	}
+}
+
+func flattenMariaDbServerSecurityAlertPolicy(d *schema.ResourceData, input *mariadb.SecurityAlertPolicyProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	policy := azure.DatabaseServerThreatDetectionPolicy{
+		Enabled: input.State == mariadb.ServerSecurityAlertPolicyStateEnabled,
+	}
+
+	if input.DisabledAlerts != nil {
+		policy.DisabledAlerts = *input.DisabledAlerts
+	}
+
+	if input.EmailAccountAdmins != nil {
+		policy.EmailAccountAdmins = *input.EmailAccountAdmins
+	}
+
+	if input.EmailAddresses != nil {
+		policy.EmailAddresses = *input.EmailAddresses
+	}
+
+	if input.RetentionDays != nil {
+		policy.RetentionDays = int(*input.RetentionDays)
+	}
+
+	if input.StorageEndpoint != nil {
+		policy.StorageEndpoint = *input.StorageEndpoint
+	}
+
+	// the Storage Account Access Key isn't returned by the API, so we pull this from the state
+	storageAccountAccessKey := d.Get("threat_detection_policy.0.storage_account_access_key").(string)
+
+	return azure.FlattenDatabaseServerThreatDetectionPolicy(policy, storageAccountAccessKey)
+}
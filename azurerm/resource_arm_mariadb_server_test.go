@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -263,6 +264,48 @@ func TestAccAzureRMMariaDbServer_updateSKU(t *testing.T) {
 
 //
 
+func TestAccAzureRMMariaDbServer_threatDetectionPolicy(t *testing.T) {
+	resourceName := "azurerm_mariadb_server.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMariaDbServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMariaDbServer_threatDetectionPolicy(ri, rs, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMariaDbServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.disabled_alerts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.email_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.retention_days", "15"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"administrator_login_password",                         // not returned as sensitive
+					"threat_detection_policy.0.storage_account_access_key", // not returned as sensitive
+				},
+			},
+			{
+				Config: testAccAzureRMMariaDbServer_threatDetectionPolicy(ri, rs, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMariaDbServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMMariaDbServerExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -581,3 +624,59 @@ resource "azurerm_mariadb_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMMariaDbServer_threatDetectionPolicy(rInt int, rString string, location string, enabled bool) string {
+	threatDetectionPolicy := ""
+	if enabled {
+		threatDetectionPolicy = `
+  threat_detection_policy {
+    disabled_alerts            = ["Sql_Injection"]
+    email_account_admins       = true
+    email_addresses            = ["security@example.com", "admin@example.com"]
+    retention_days             = 15
+    storage_endpoint           = "${azurerm_storage_account.test.primary_blob_endpoint}"
+    storage_account_access_key = "${azurerm_storage_account.test.primary_access_key}"
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_mariadb_server" "test" {
+  name                = "acctestmariadbsvr-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_2"
+    capacity = 2
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  administrator_login          = "acctestun"
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "10.2"
+  ssl_enforcement              = "Enabled"
+%s
+}
+`, rInt, location, rString, rInt, threatDetectionPolicy)
+}
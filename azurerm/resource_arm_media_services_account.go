@@ -1,15 +1,19 @@
 package azurerm
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/mediaservices/mgmt/2018-07-01/media"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -44,9 +48,10 @@ func resourceArmMediaServicesAccount() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"is_primary": {
@@ -56,6 +61,7 @@ func resourceArmMediaServicesAccount() *schema.Resource {
 						},
 					},
 				},
+				Set: resourceArmMediaServicesAccountStorageAccountHash,
 			},
 
 			// TODO: support Tags when this bug is fixed:
@@ -92,7 +98,7 @@ func resourceArmMediaServicesAccountCreateUpdate(d *schema.ResourceData, meta in
 
 	service, err := client.Get(ctx, resourceGroup, accountName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Media Service Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Media Service Account %q (Resource Group %q): %s", accountName, resourceGroup, azure.FormatError(err))
 	}
 	d.SetId(*service.ID)
 
@@ -119,7 +125,7 @@ func resourceArmMediaServicesAccountRead(d *schema.ResourceData, meta interface{
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Media Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Media Services Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -157,7 +163,7 @@ func resourceArmMediaServicesAccountDelete(d *schema.ResourceData, meta interfac
 		if response.WasNotFound(resp.Response) {
 			return nil
 		}
-		return fmt.Errorf("Error issuing AzureRM delete request for Media Services Account '%s': %+v", name, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Media Services Account '%s': %s", name, azure.FormatError(err))
 	}
 
 	return nil
@@ -213,3 +219,15 @@ func flattenMediaServicesAccountStorageAccounts(input *[]media.StorageAccount) [
 
 	return results
 }
+
+func resourceArmMediaServicesAccountStorageAccountHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		// Resource IDs are case-insensitive, and Azure doesn't always return them with the same casing
+		buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["id"].(string))))
+		buf.WriteString(fmt.Sprintf("%t-", m["is_primary"].(bool)))
+	}
+
+	return hashcode.String(buf.String())
+}
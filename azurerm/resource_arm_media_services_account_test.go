@@ -12,6 +12,29 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
+func TestResourceArmMediaServicesAccountStorageAccountHash(t *testing.T) {
+	configured := map[string]interface{}{
+		"id":         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+		"is_primary": true,
+	}
+	returned := map[string]interface{}{
+		"id":         "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.storage/storageAccounts/account1",
+		"is_primary": true,
+	}
+	secondary := map[string]interface{}{
+		"id":         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+		"is_primary": false,
+	}
+
+	if resourceArmMediaServicesAccountStorageAccountHash(configured) != resourceArmMediaServicesAccountStorageAccountHash(returned) {
+		t.Fatalf("Expected the hash to ignore the casing of `id`")
+	}
+
+	if resourceArmMediaServicesAccountStorageAccountHash(configured) == resourceArmMediaServicesAccountStorageAccountHash(secondary) {
+		t.Fatalf("Expected the hash to change when `is_primary` changes")
+	}
+}
+
 func TestAccAzureRMMediaServicesAccount_basic(t *testing.T) {
 	resourceName := "azurerm_media_services_account.test"
 	ri := tf.AccRandTimeInt()
@@ -0,0 +1,378 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/mediaservices/mgmt/2018-07-01/media"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMediaTransform() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMediaTransformCreateUpdate,
+		Read:   resourceArmMediaTransformRead,
+		Update: resourceArmMediaTransformCreateUpdate,
+		Delete: resourceArmMediaTransformDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[-a-zA-Z0-9._]{1,128}$"),
+					"Transform name must be 1 - 128 characters long, and can only contain letters, numbers, periods, underscores and hyphens.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"media_services_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"output": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"on_error_action": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(media.StopProcessingJob),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(media.ContinueJob),
+								string(media.StopProcessingJob),
+							}, false),
+						},
+
+						"relative_priority": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(media.Normal),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(media.High),
+								string(media.Low),
+								string(media.Normal),
+							}, false),
+						},
+
+						"builtin_preset": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"preset_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(media.AACGoodQualityAudio),
+											string(media.AdaptiveStreaming),
+											string(media.H264MultipleBitrate1080p),
+											string(media.H264MultipleBitrate720p),
+											string(media.H264MultipleBitrateSD),
+											string(media.H264SingleBitrate1080p),
+											string(media.H264SingleBitrate720p),
+											string(media.H264SingleBitrateSD),
+										}, false),
+									},
+								},
+							},
+						},
+
+						"audio_analyzer_preset": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audio_language": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+
+						"video_analyzer_preset": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audio_language": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"insights_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  string(media.AllInsights),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(media.AllInsights),
+											string(media.AudioInsightsOnly),
+											string(media.VideoInsightsOnly),
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmMediaTransformCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mediaTransformsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("media_services_account_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Transform %q (Media Services Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_media_transform", *existing.ID)
+		}
+	}
+
+	outputs, err := expandMediaTransformOutputs(d.Get("output").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := media.Transform{
+		TransformProperties: &media.TransformProperties{
+			Description: utils.String(d.Get("description").(string)),
+			Outputs:     outputs,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, accountName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Transform %q (Media Services Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	transform, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Transform %q (Media Services Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	if transform.ID == nil {
+		return fmt.Errorf("Cannot read ID for Transform %q (Media Services Account %q / Resource Group %q)", name, accountName, resourceGroup)
+	}
+
+	d.SetId(*transform.ID)
+
+	return resourceArmMediaTransformRead(d, meta)
+}
+
+func resourceArmMediaTransformRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mediaTransformsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["mediaservices"]
+	name := id.Path["transforms"]
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Transform %q was not found in Media Services Account %q (Resource Group %q) - removing from state", name, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Transform %q (Media Services Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("media_services_account_name", accountName)
+
+	if props := resp.TransformProperties; props != nil {
+		d.Set("description", props.Description)
+
+		outputs, err := flattenMediaTransformOutputs(props.Outputs)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("output", outputs); err != nil {
+			return fmt.Errorf("Error setting `output`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMediaTransformDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mediaTransformsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["mediaservices"]
+	name := id.Path["transforms"]
+
+	resp, err := client.Delete(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if response.WasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Transform %q (Media Services Account %q / Resource Group %q): %s", name, accountName, resourceGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
+func expandMediaTransformOutputs(input []interface{}) (*[]media.TransformOutput, error) {
+	outputs := make([]media.TransformOutput, 0)
+
+	for i, raw := range input {
+		v := raw.(map[string]interface{})
+
+		presets := make([]media.BasicPreset, 0)
+
+		if builtin := v["builtin_preset"].([]interface{}); len(builtin) > 0 && builtin[0] != nil {
+			preset := builtin[0].(map[string]interface{})
+			presets = append(presets, media.BuiltInStandardEncoderPreset{
+				PresetName: media.EncoderNamedPreset(preset["preset_name"].(string)),
+				OdataType:  media.OdataTypeMicrosoftMediaBuiltInStandardEncoderPreset,
+			})
+		}
+
+		if audio := v["audio_analyzer_preset"].([]interface{}); len(audio) > 0 {
+			preset := media.AudioAnalyzerPreset{
+				OdataType: media.OdataTypeMicrosoftMediaAudioAnalyzerPreset,
+			}
+			if audio[0] != nil {
+				if language := audio[0].(map[string]interface{})["audio_language"].(string); language != "" {
+					preset.AudioLanguage = utils.String(language)
+				}
+			}
+			presets = append(presets, preset)
+		}
+
+		if video := v["video_analyzer_preset"].([]interface{}); len(video) > 0 {
+			preset := media.VideoAnalyzerPreset{
+				InsightsToExtract: media.AllInsights,
+				OdataType:         media.OdataTypeMicrosoftMediaVideoAnalyzerPreset,
+			}
+			if video[0] != nil {
+				settings := video[0].(map[string]interface{})
+				if language := settings["audio_language"].(string); language != "" {
+					preset.AudioLanguage = utils.String(language)
+				}
+				preset.InsightsToExtract = media.InsightsType(settings["insights_type"].(string))
+			}
+			presets = append(presets, preset)
+		}
+
+		if len(presets) != 1 {
+			return nil, fmt.Errorf("Error: `output.%d` must specify exactly one of `builtin_preset`, `audio_analyzer_preset` or `video_analyzer_preset`", i)
+		}
+
+		outputs = append(outputs, media.TransformOutput{
+			OnError:          media.OnErrorType(v["on_error_action"].(string)),
+			RelativePriority: media.Priority(v["relative_priority"].(string)),
+			Preset:           presets[0],
+		})
+	}
+
+	return &outputs, nil
+}
+
+func flattenMediaTransformOutputs(input *[]media.TransformOutput) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, v := range *input {
+		output := map[string]interface{}{
+			"on_error_action":       string(v.OnError),
+			"relative_priority":     string(v.RelativePriority),
+			"builtin_preset":        []interface{}{},
+			"audio_analyzer_preset": []interface{}{},
+			"video_analyzer_preset": []interface{}{},
+		}
+
+		switch preset := v.Preset.(type) {
+		case media.BuiltInStandardEncoderPreset:
+			output["builtin_preset"] = []interface{}{
+				map[string]interface{}{
+					"preset_name": string(preset.PresetName),
+				},
+			}
+		case media.AudioAnalyzerPreset:
+			language := ""
+			if preset.AudioLanguage != nil {
+				language = *preset.AudioLanguage
+			}
+			output["audio_analyzer_preset"] = []interface{}{
+				map[string]interface{}{
+					"audio_language": language,
+				},
+			}
+		case media.VideoAnalyzerPreset:
+			language := ""
+			if preset.AudioLanguage != nil {
+				language = *preset.AudioLanguage
+			}
+			output["video_analyzer_preset"] = []interface{}{
+				map[string]interface{}{
+					"audio_language": language,
+					"insights_type":  string(preset.InsightsToExtract),
+				},
+			}
+		default:
+			return nil, fmt.Errorf("Error: Transform Output uses an unsupported Preset of type %T", v.Preset)
+		}
+
+		results = append(results, output)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,164 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMediaTransform_basic(t *testing.T) {
+	resourceName := "azurerm_media_transform.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMediaTransformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMediaTransform_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMediaTransformExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.builtin_preset.0.preset_name", "AdaptiveStreaming"),
+				),
+			},
+			{
+				Config: testAccAzureRMMediaTransform_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMediaTransformExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Encodes and analyzes uploaded videos"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "output.1.on_error_action", "ContinueJob"),
+					resource.TestCheckResourceAttr(resourceName, "output.1.audio_analyzer_preset.0.audio_language", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "output.2.video_analyzer_preset.0.insights_type", "VideoInsightsOnly"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMediaTransformExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["media_services_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).mediaTransformsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Transform %q (Media Services Account %q / Resource Group %q) does not exist", name, accountName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on mediaTransformsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMediaTransformDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).mediaTransformsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_media_transform" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["media_services_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Transform %q (Media Services Account %q / Resource Group %q) still exists", name, accountName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMediaTransform_basic(rInt int, rString, location string) string {
+	template := testAccAzureRMMediaServicesAccount_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_media_transform" "test" {
+  name                        = "acctesttransform%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  media_services_account_name = "${azurerm_media_services_account.test.name}"
+
+  output {
+    builtin_preset {
+      preset_name = "AdaptiveStreaming"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMediaTransform_complete(rInt int, rString, location string) string {
+	template := testAccAzureRMMediaServicesAccount_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_media_transform" "test" {
+  name                        = "acctesttransform%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  media_services_account_name = "${azurerm_media_services_account.test.name}"
+  description                 = "Encodes and analyzes uploaded videos"
+
+  output {
+    relative_priority = "High"
+
+    builtin_preset {
+      preset_name = "AdaptiveStreaming"
+    }
+  }
+
+  output {
+    on_error_action   = "ContinueJob"
+    relative_priority = "Low"
+
+    audio_analyzer_preset {
+      audio_language = "en-US"
+    }
+  }
+
+  output {
+    on_error_action = "ContinueJob"
+
+    video_analyzer_preset {
+      insights_type = "VideoInsightsOnly"
+    }
+  }
+}
+`, template, rInt)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -122,7 +123,7 @@ func resourceArmMonitorActionGroupCreateUpdate(d *schema.ResourceData, meta inte
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Monitor Action Group Service Plan %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Monitor Action Group Service Plan %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -154,12 +155,12 @@ func resourceArmMonitorActionGroupCreateUpdate(d *schema.ResourceData, meta inte
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, name, parameters); err != nil {
-		return fmt.Errorf("Error creating or updating action group %q (resource group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error creating or updating action group %q (resource group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error getting action group %q (resource group %q) after creation: %+v", name, resGroup, err)
+		return fmt.Errorf("Error getting action group %q (resource group %q) after creation: %s", name, resGroup, azure.FormatError(err))
 	}
 	if read.ID == nil {
 		return fmt.Errorf("Action group %q (resource group %q) ID is empty", name, resGroup)
@@ -187,7 +188,7 @@ func resourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting action group %q (resource group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error getting action group %q (resource group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", name)
@@ -229,7 +230,7 @@ func resourceArmMonitorActionGroupDelete(d *schema.ResourceData, meta interface{
 	resp, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("Error deleting action group %q (resource group %q): %+v", name, resGroup, err)
+			return fmt.Errorf("Error deleting action group %q (resource group %q): %s", name, resGroup, azure.FormatError(err))
 		}
 	}
 
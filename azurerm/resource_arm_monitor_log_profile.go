@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -33,14 +34,16 @@ func resourceArmMonitorLogProfile() *schema.Resource {
 				ValidateFunc: validate.NoEmptyStrings,
 			},
 			"storage_account_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"servicebus_rule_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"locations": {
 				Type:     schema.TypeSet,
@@ -94,7 +97,7 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Monitor Log Profile %q: %s", name, err)
+				return fmt.Errorf("Error checking for presence of existing Monitor Log Profile %q: %s", name, azure.FormatError(err))
 			}
 		}
 
@@ -129,7 +132,7 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, name, parameters); err != nil {
-		return fmt.Errorf("Error Creating/Updating Log Profile %q: %+v", name, err)
+		return fmt.Errorf("Error Creating/Updating Log Profile %q: %s", name, azure.FormatError(err))
 	}
 
 	// Wait for Log Profile to become available
@@ -139,7 +142,7 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	read, err := client.Get(ctx, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Log Profile %q: %+v", name, err)
+		return fmt.Errorf("Error retrieving Log Profile %q: %s", name, azure.FormatError(err))
 	}
 
 	d.SetId(*read.ID)
@@ -163,7 +166,7 @@ func resourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Azure Log Profile %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Azure Log Profile %q: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -195,7 +198,7 @@ func resourceArmLogProfileDelete(d *schema.ResourceData, meta interface{}) error
 
 	_, err = client.Delete(ctx, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting Log Profile %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Log Profile %q: %s", name, azure.FormatError(err))
 	}
 
 	return nil
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -109,16 +112,45 @@ func resourceArmMySqlServer() *schema.Resource {
 
 			"administrator_login": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
 			"administrator_login_password": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
 
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(mysql.CreateModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(mysql.CreateModeDefault),
+					string(mysql.CreateModeGeoRestore),
+					string(mysql.CreateModePointInTimeRestore),
+					string(mysql.CreateModeReplica),
+				}, false),
+			},
+
+			"creation_source_server_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"restore_point_in_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+
 			"version": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -169,6 +201,8 @@ func resourceArmMySqlServer() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"threat_detection_policy": azure.SchemaDatabaseServerThreatDetectionPolicy(),
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -186,7 +220,7 @@ func resourceArmMySqlServer() *schema.Resource {
 				return fmt.Errorf("basic pricing tier only supports upto 1,048,576 MB (1TB) of storage")
 			}
 
-			return nil
+			return validateDatabaseServerCreateMode(diff)
 		},
 	}
 }
@@ -201,18 +235,13 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	adminLogin := d.Get("administrator_login").(string)
-	adminLoginPassword := d.Get("administrator_login_password").(string)
-	sslEnforcement := d.Get("ssl_enforcement").(string)
-	version := d.Get("version").(string)
-	createMode := "Default"
 	tags := d.Get("tags").(map[string]interface{})
 
 	if requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing MySQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -222,34 +251,31 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	sku := expandMySQLServerSku(d)
-	storageProfile := expandMySQLStorageProfile(d)
+
+	createProperties, err := expandMySQLServerPropertiesForCreate(d)
+	if err != nil {
+		return err
+	}
 
 	properties := mysql.ServerForCreate{
-		Location: &location,
-		Properties: &mysql.ServerPropertiesForDefaultCreate{
-			AdministratorLogin:         utils.String(adminLogin),
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    mysql.ServerVersion(version),
-			SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
-			StorageProfile:             storageProfile,
-			CreateMode:                 mysql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: expandTags(tags),
+		Location:   &location,
+		Properties: createProperties,
+		Sku:        sku,
+		Tags:       expandTags(tags),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error creating MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating MySQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for creation of MySQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving MySQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -258,6 +284,12 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("threat_detection_policy") {
+		if err := updateMySQLServerSecurityAlertPolicy(d, meta, resourceGroup, name); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmMySqlServerRead(d, meta)
 }
 
@@ -279,27 +311,31 @@ func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	properties := mysql.ServerUpdateParameters{
 		ServerUpdateParametersProperties: &mysql.ServerUpdateParametersProperties{
-			StorageProfile:             storageProfile,
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    mysql.ServerVersion(version),
-			SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			Version:        mysql.ServerVersion(version),
+			SslEnforcement: mysql.SslEnforcementEnum(sslEnforcement),
 		},
 		Sku:  sku,
 		Tags: expandTags(tags),
 	}
 
+	// the password is inherited from the source server when it's created as a Replica or restored from a backup
+	if adminLoginPassword != "" {
+		properties.ServerUpdateParametersProperties.AdministratorLoginPassword = utils.String(adminLoginPassword)
+	}
+
 	future, err := client.Update(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error updating MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error updating MySQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for MySQL Server %q (Resource Group %q) to finish updating: %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for MySQL Server %q (Resource Group %q) to finish updating: %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving MySQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -308,6 +344,12 @@ func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("threat_detection_policy") {
+		if err := updateMySQLServerSecurityAlertPolicy(d, meta, resourceGroup, name); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmMySqlServerRead(d, meta)
 }
 
@@ -329,7 +371,7 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Azure MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Azure MySQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -340,6 +382,17 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("administrator_login", resp.AdministratorLogin)
+
+	// the Create Mode isn't returned by the API - so if it's not already set (e.g. when importing) we infer
+	// this from the Replication Role, since Restored servers are otherwise indistinguishable from new ones
+	if _, ok := d.GetOk("create_mode"); !ok {
+		createMode := string(mysql.CreateModeDefault)
+		if role := resp.ReplicationRole; role != nil && strings.EqualFold(*role, "Replica") {
+			createMode = string(mysql.CreateModeReplica)
+			d.Set("creation_source_server_id", resp.MasterServerID)
+		}
+		d.Set("create_mode", createMode)
+	}
 	d.Set("version", string(resp.Version))
 	d.Set("ssl_enforcement", string(resp.SslEnforcement))
 
@@ -353,6 +406,15 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 
 	flattenAndSetTags(d, resp.Tags)
 
+	// Threat Detection isn't supported for all SKU's, so we only log any error when retrieving the Policy
+	securityAlertPoliciesClient := meta.(*ArmClient).mysqlServerSecurityAlertPoliciesClient
+	policy, err := securityAlertPoliciesClient.Get(ctx, resourceGroup, name)
+	if err != nil {
+		log.Printf("[WARN] Error retrieving Threat Detection Policy for MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	} else if err := d.Set("threat_detection_policy", flattenMySQLServerSecurityAlertPolicy(d, policy.SecurityAlertPolicyProperties)); err != nil {
+		return fmt.Errorf("Error setting `threat_detection_policy`: %+v", err)
+	}
+
 	// Computed
 	d.Set("fqdn", resp.FullyQualifiedDomainName)
 
@@ -372,16 +434,78 @@ func resourceArmMySqlServerDelete(d *schema.ResourceData, meta interface{}) erro
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error deleting MySQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for deletion of MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for deletion of MySQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	return nil
 }
 
+func expandMySQLServerPropertiesForCreate(d *schema.ResourceData) (mysql.BasicServerPropertiesForCreate, error) {
+	adminLogin := d.Get("administrator_login").(string)
+	adminLoginPassword := d.Get("administrator_login_password").(string)
+	createMode := mysql.CreateMode(d.Get("create_mode").(string))
+	sourceServerId := d.Get("creation_source_server_id").(string)
+	restorePointInTime := d.Get("restore_point_in_time").(string)
+	sslEnforcement := mysql.SslEnforcementEnum(d.Get("ssl_enforcement").(string))
+	storageProfile := expandMySQLStorageProfile(d)
+	version := mysql.ServerVersion(d.Get("version").(string))
+
+	// the fields required by each `create_mode` are validated in the CustomizeDiff - however since `administrator_login`
+	// is Computed, it's not possible to tell there whether it's been omitted, so this is checked again here
+	if createMode == mysql.CreateModeDefault {
+		if adminLogin == "" || adminLoginPassword == "" {
+			return nil, fmt.Errorf("`administrator_login` and `administrator_login_password` must be specified when `create_mode` is `Default`")
+		}
+
+		return &mysql.ServerPropertiesForDefaultCreate{
+			AdministratorLogin:         utils.String(adminLogin),
+			AdministratorLoginPassword: utils.String(adminLoginPassword),
+			Version:                    version,
+			SslEnforcement:             sslEnforcement,
+			StorageProfile:             storageProfile,
+			CreateMode:                 createMode,
+		}, nil
+	}
+
+	switch createMode {
+	case mysql.CreateModeGeoRestore:
+		return &mysql.ServerPropertiesForGeoRestore{
+			SourceServerID: utils.String(sourceServerId),
+			Version:        version,
+			SslEnforcement: sslEnforcement,
+			StorageProfile: storageProfile,
+			CreateMode:     createMode,
+		}, nil
+
+	case mysql.CreateModePointInTimeRestore:
+		// this has already been validated by the schema
+		restoreTime, _ := date.ParseTime(time.RFC3339, restorePointInTime)
+		return &mysql.ServerPropertiesForRestore{
+			SourceServerID:     utils.String(sourceServerId),
+			RestorePointInTime: &date.Time{Time: restoreTime},
+			Version:            version,
+			SslEnforcement:     sslEnforcement,
+			StorageProfile:     storageProfile,
+			CreateMode:         createMode,
+		}, nil
+
+	case mysql.CreateModeReplica:
+		return &mysql.ServerPropertiesForReplica{
+			SourceServerID: utils.String(sourceServerId),
+			Version:        version,
+			SslEnforcement: sslEnforcement,
+			StorageProfile: storageProfile,
+			CreateMode:     createMode,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported `create_mode` %q", string(createMode))
+}
+
 func expandMySQLServerSku(d *schema.ResourceData) *mysql.Sku {
 	skus := d.Get("sku").([]interface{})
 	sku := skus[0].(map[string]interface{})
@@ -449,3 +573,86 @@ func flattenMySQLStorageProfile(resp *mysql.StorageProfile) []interface{} {
 
 	return []interface{}{values}
 }
+
+func updateMySQLServerSecurityAlertPolicy(d *schema.ResourceData, meta interface{}, resourceGroup, name string) error {
+	client := meta.(*ArmClient).mysqlServerSecurityAlertPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] Updating Threat Detection Policy for MySQL Server %q (Resource Group %q)", name, resourceGroup)
+
+	policy := expandMySQLServerSecurityAlertPolicy(d)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, policy)
+	if err != nil {
+		return fmt.Errorf("Error updating Threat Detection Policy for MySQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Threat Detection Policy for MySQL Server %q (Resource Group %q) to finish updating: %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
+func expandMySQLServerSecurityAlertPolicy(d *schema.ResourceData) mysql.ServerSecurityAlertPolicy {
+	policy := azure.ExpandDatabaseServerThreatDetectionPolicy(d.Get("threat_detection_policy").([]interface{}))
+
+	state := mysql.ServerSecurityAlertPolicyStateDisabled
+	if policy.Enabled {
+		state = mysql.ServerSecurityAlertPolicyStateEnabled
+	}
+
+	properties := mysql.SecurityAlertPolicyProperties{
+		State:              state,
+		DisabledAlerts:     &policy.DisabledAlerts,
+		EmailAccountAdmins: utils.Bool(policy.EmailAccountAdmins),
+		EmailAddresses:     &policy.EmailAddresses,
+		RetentionDays:      utils.Int32(int32(policy.RetentionDays)),
+	}
+
+	if policy.StorageAccountAccessKey != "" {
+		properties.StorageAccountAccessKey = utils.String(policy.StorageAccountAccessKey)
+	}
+
+	if policy.StorageEndpoint != "" {
+		properties.StorageEndpoint = utils.String(policy.StorageEndpoint)
+	}
+
+	return mysql.ServerSecurityAlertPolicy{
+		SecurityAlertPolicyProperties: &properties,
+	}
+}
+
+func flattenMySQLServerSecurityAlertPolicy(d *schema.ResourceData, input *mysql.SecurityAlertPolicyProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	policy := azure.DatabaseServerThreatDetectionPolicy{
+		Enabled: input.State == mysql.ServerSecurityAlertPolicyStateEnabled,
+	}
+
+	if input.DisabledAlerts != nil {
+		policy.DisabledAlerts = *input.DisabledAlerts
+	}
+
+	if input.EmailAccountAdmins != nil {
+		policy.EmailAccountAdmins = *input.EmailAccountAdmins
+	}
+
+	if input.EmailAddresses != nil {
+		policy.EmailAddresses = *input.EmailAddresses
+	}
+
+	if input.RetentionDays != nil {
+		policy.RetentionDays = int(*input.RetentionDays)
+	}
+
+	if input.StorageEndpoint != nil {
+		policy.StorageEndpoint = *input.StorageEndpoint
+	}
+
+	// the Storage Account Access Key isn't returned by the API, so we pull this from the state
+	storageAccountAccessKey := d.Get("threat_detection_policy.0.storage_account_access_key").(string)
+
+	return azure.FlattenDatabaseServerThreatDetectionPolicy(policy, storageAccountAccessKey)
+}
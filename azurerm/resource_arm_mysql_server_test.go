@@ -3,7 +3,9 @@ package azurerm
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -239,6 +241,108 @@ func TestAccAzureRMMySQLServer_updateSKU(t *testing.T) {
 
 //
 
+func TestAccAzureRMMySQLServer_createPointInTimeRestore(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	restoreResourceName := "azurerm_mysql_server.restore"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	restoreTime := time.Now().Add(11 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMySQLServer_generalPurpose(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+				),
+			},
+			{
+				// the restore point has to be after the earliest restore date of the source server
+				PreConfig: func() { time.Sleep(time.Until(restoreTime)) },
+				Config:    testAccAzureRMMySQLServer_createPointInTimeRestore(ri, location, restoreTime.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+					testCheckAzureRMMySQLServerExists(restoreResourceName),
+					resource.TestCheckResourceAttr(restoreResourceName, "administrator_login", "acctestun"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMySQLServer_createReplica(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	replicaResourceName := "azurerm_mysql_server.replica"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMySQLServer_createReplica(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+					testCheckAzureRMMySQLServerExists(replicaResourceName),
+					resource.TestCheckResourceAttr(replicaResourceName, "create_mode", "Replica"),
+				),
+			},
+			{
+				ResourceName:      replicaResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMySQLServer_threatDetectionPolicy(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMySQLServer_threatDetectionPolicy(ri, rs, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.disabled_alerts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.email_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.retention_days", "15"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"administrator_login_password",                         // not returned as sensitive
+					"threat_detection_policy.0.storage_account_access_key", // not returned as sensitive
+				},
+			},
+			{
+				Config: testAccAzureRMMySQLServer_threatDetectionPolicy(ri, rs, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMMySQLServerExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -521,3 +625,122 @@ resource "azurerm_mysql_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMMySQLServer_createPointInTimeRestore(rInt int, location, restoreTime string) string {
+	template := testAccAzureRMMySQLServer_generalPurpose(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_server" "restore" {
+  name                = "acctestmysqlsvr-%d-restore"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_32"
+    capacity = 32
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 640000
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  create_mode               = "PointInTimeRestore"
+  creation_source_server_id = "${azurerm_mysql_server.test.id}"
+  restore_point_in_time     = "%s"
+  version                   = "5.7"
+  ssl_enforcement           = "Enabled"
+}
+`, template, rInt, restoreTime)
+}
+
+func testAccAzureRMMySQLServer_createReplica(rInt int, location string) string {
+	template := testAccAzureRMMySQLServer_generalPurpose(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_server" "replica" {
+  name                = "acctestmysqlsvr-%d-replica"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_32"
+    capacity = 32
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 640000
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  create_mode               = "Replica"
+  creation_source_server_id = "${azurerm_mysql_server.test.id}"
+  version                   = "5.7"
+  ssl_enforcement           = "Enabled"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMySQLServer_threatDetectionPolicy(rInt int, rString string, location string, enabled bool) string {
+	threatDetectionPolicy := ""
+	if enabled {
+		threatDetectionPolicy = `
+  threat_detection_policy {
+    disabled_alerts            = ["Sql_Injection"]
+    email_account_admins       = true
+    email_addresses            = ["security@example.com", "admin@example.com"]
+    retention_days             = 15
+    storage_endpoint           = "${azurerm_storage_account.test.primary_blob_endpoint}"
+    storage_account_access_key = "${azurerm_storage_account.test.primary_access_key}"
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_mysql_server" "test" {
+  name                = "acctestmysqlsvr-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_2"
+    capacity = 2
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  administrator_login          = "acctestun"
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "5.7"
+  ssl_enforcement              = "Enabled"
+%s
+}
+`, rInt, location, rString, rInt, threatDetectionPolicy)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -24,10 +25,11 @@ func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(
 
 		Schema: map[string]*schema.Schema{
 			"network_interface_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"ip_configuration_name": {
@@ -38,10 +40,11 @@ func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(
 			},
 
 			"backend_address_pool_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 		},
 	}
@@ -65,8 +68,8 @@ func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationC
 	networkInterfaceName := id.Path["networkInterfaces"]
 	resourceGroup := id.ResourceGroup
 
-	azureRMLockByName(networkInterfaceName, networkInterfaceResourceName)
-	defer azureRMUnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	azureRMLockByID(networkInterfaceId)
+	defer azureRMUnlockByID(networkInterfaceId)
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
@@ -74,7 +77,7 @@ func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationC
 			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %s", networkInterfaceName, resourceGroup, azure.FormatError(err))
 	}
 
 	props := read.InterfacePropertiesFormat
@@ -126,13 +129,16 @@ func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationC
 
 	props.IPConfigurations = azure.UpdateNetworkInterfaceIPConfiguration(config, props.IPConfigurations)
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
-	if err != nil {
-		return fmt.Errorf("Error updating Application Gateway Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
-	}
+	err = azureRMRetryWhileParentResourceIsBusy(func() error {
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+		if err != nil {
+			return err
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Application Gateway Backend Address Pool Association for NIC %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return future.WaitForCompletionRef(ctx, client.Client)
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Application Gateway Backend Address Pool Association for Network Interface %q (Resource Group %q): %s", networkInterfaceName, resourceGroup, azure.FormatError(err))
 	}
 
 	d.SetId(resourceId)
@@ -165,7 +171,7 @@ func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationR
 			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %s", networkInterfaceName, resourceGroup, azure.FormatError(err))
 	}
 
 	nicProps := read.InterfacePropertiesFormat
@@ -236,8 +242,8 @@ func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationD
 	resourceGroup := nicID.ResourceGroup
 	backendAddressPoolId := splitId[1]
 
-	azureRMLockByName(networkInterfaceName, networkInterfaceResourceName)
-	defer azureRMUnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	azureRMLockByID(splitId[0])
+	defer azureRMUnlockByID(splitId[0])
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
@@ -245,7 +251,7 @@ func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationD
 			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %s", networkInterfaceName, resourceGroup, azure.FormatError(err))
 	}
 
 	nicProps := read.InterfacePropertiesFormat
@@ -284,13 +290,16 @@ func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationD
 	props.ApplicationGatewayBackendAddressPools = &backendAddressPools
 	nicProps.IPConfigurations = azure.UpdateNetworkInterfaceIPConfiguration(config, nicProps.IPConfigurations)
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
-	if err != nil {
-		return fmt.Errorf("Error removing Application Gateway Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
-	}
+	err = azureRMRetryWhileParentResourceIsBusy(func() error {
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+		if err != nil {
+			return err
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for removal of Application Gateway Backend Address Pool Association for NIC %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return future.WaitForCompletionRef(ctx, client.Client)
+	})
+	if err != nil {
+		return fmt.Errorf("Error removing Application Gateway Backend Address Pool Association for Network Interface %q (Resource Group %q): %s", networkInterfaceName, resourceGroup, azure.FormatError(err))
 	}
 
 	return nil
@@ -0,0 +1,272 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkSecurityGroupRulesCreateUpdate,
+		Read:   resourceArmNetworkSecurityGroupRulesRead,
+		Update: resourceArmNetworkSecurityGroupRulesCreateUpdate,
+		Delete: resourceArmNetworkSecurityGroupRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmNetworkSecurityGroupRulesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"network_security_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"security_rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     networkSecurityGroupSecurityRuleSchema(),
+			},
+		},
+	}
+}
+
+func resourceArmNetworkSecurityGroupRulesCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).secGroupClient
+	ctx := meta.(*ArmClient).StopContext
+
+	nsgName := d.Get("network_security_group_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	rules, err := expandNetworkSecurityRules(d.Get("security_rule").(*schema.Set).List())
+	if err != nil {
+		return fmt.Errorf("Error Building list of Network Security Group Rules: %+v", err)
+	}
+
+	// the Rules previously managed by this resource are replaced, so they're removed from the existing Rules
+	oldRaw, _ := d.GetChange("security_rule")
+	managed := networkSecurityGroupRuleNames(oldRaw.(*schema.Set).List())
+	for _, rule := range rules {
+		managed[strings.ToLower(*rule.Name)] = true
+	}
+
+	azureRMLockByName(nsgName, networkSecurityGroupResourceName)
+	defer azureRMUnlockByName(nsgName, networkSecurityGroupResourceName)
+
+	nsg, err := client.Get(ctx, resGroup, nsgName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): %s", nsgName, resGroup, azure.FormatError(err))
+	}
+
+	if nsg.ID == nil || nsg.SecurityGroupPropertiesFormat == nil {
+		return fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): `properties` was nil", nsgName, resGroup)
+	}
+
+	existing := make([]network.SecurityRule, 0)
+	if nsg.SecurityRules != nil {
+		existing = *nsg.SecurityRules
+	}
+
+	if d.IsNewResource() {
+		for _, rule := range existing {
+			if rule.Name == nil {
+				continue
+			}
+
+			if managed[strings.ToLower(*rule.Name)] {
+				return fmt.Errorf("A Security Rule named %q already exists in Network Security Group %q (Resource Group %q) - to be managed via Terraform this needs to be imported into the State", *rule.Name, nsgName, resGroup)
+			}
+		}
+	}
+
+	securityRules := make([]network.SecurityRule, 0)
+	for _, rule := range existing {
+		if rule.Name != nil && managed[strings.ToLower(*rule.Name)] {
+			continue
+		}
+
+		securityRules = append(securityRules, rule)
+	}
+	securityRules = append(securityRules, rules...)
+	nsg.SecurityRules = &securityRules
+
+	// all of the Security Rules are submitted in a single request, rather than one request per rule
+	log.Printf("[DEBUG] Updating %d Security Rules in Network Security Group %q (Resource Group %q)", len(rules), nsgName, resGroup)
+	future, err := client.CreateOrUpdate(ctx, resGroup, nsgName, nsg)
+	if err != nil {
+		return fmt.Errorf("Error updating Security Rules in Network Security Group %q (Resource Group %q): %s", nsgName, resGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Security Rules in Network Security Group %q (Resource Group %q): %s", nsgName, resGroup, azure.FormatError(err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/securityRules", *nsg.ID))
+
+	return resourceArmNetworkSecurityGroupRulesRead(d, meta)
+}
+
+func resourceArmNetworkSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).secGroupClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseNetworkSecurityGroupRulesID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nsgName := id.Path["networkSecurityGroups"]
+
+	resp, err := client.Get(ctx, resGroup, nsgName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Network Security Group %q was not found in Resource Group %q - removing from state", nsgName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Network Security Group %q (Resource Group %q): %s", nsgName, resGroup, azure.FormatError(err))
+	}
+
+	d.Set("network_security_group_name", nsgName)
+	d.Set("resource_group_name", resGroup)
+
+	// only the Security Rules managed by this resource are tracked, since others may be managed elsewhere
+	managed := networkSecurityGroupRuleNames(d.Get("security_rule").(*schema.Set).List())
+
+	rules := make([]network.SecurityRule, 0)
+	if props := resp.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+		for _, rule := range *props.SecurityRules {
+			if rule.Name == nil || !managed[strings.ToLower(*rule.Name)] {
+				continue
+			}
+
+			rules = append(rules, rule)
+		}
+	}
+
+	if len(rules) < len(managed) {
+		log.Printf("[WARN] %d Security Rule(s) managed by this resource were removed from Network Security Group %q (Resource Group %q) - this happens when in-line `security_rule` blocks are used on the `azurerm_network_security_group` resource, which isn't supported", len(managed)-len(rules), nsgName, resGroup)
+	}
+
+	if err := d.Set("security_rule", flattenNetworkSecurityRules(&rules)); err != nil {
+		return fmt.Errorf("Error setting `security_rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkSecurityGroupRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).secGroupClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseNetworkSecurityGroupRulesID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nsgName := id.Path["networkSecurityGroups"]
+
+	managed := networkSecurityGroupRuleNames(d.Get("security_rule").(*schema.Set).List())
+
+	azureRMLockByName(nsgName, networkSecurityGroupResourceName)
+	defer azureRMUnlockByName(nsgName, networkSecurityGroupResourceName)
+
+	nsg, err := client.Get(ctx, resGroup, nsgName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(nsg.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): %s", nsgName, resGroup, azure.FormatError(err))
+	}
+
+	if nsg.SecurityGroupPropertiesFormat == nil || nsg.SecurityRules == nil {
+		return nil
+	}
+
+	securityRules := make([]network.SecurityRule, 0)
+	for _, rule := range *nsg.SecurityRules {
+		if rule.Name != nil && managed[strings.ToLower(*rule.Name)] {
+			continue
+		}
+
+		securityRules = append(securityRules, rule)
+	}
+	nsg.SecurityRules = &securityRules
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, nsgName, nsg)
+	if err != nil {
+		return fmt.Errorf("Error removing Security Rules from Network Security Group %q (Resource Group %q): %s", nsgName, resGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Security Rules from Network Security Group %q (Resource Group %q): %s", nsgName, resGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
+func networkSecurityGroupRuleNames(input []interface{}) map[string]bool {
+	names := make(map[string]bool)
+	for _, raw := range input {
+		rule := raw.(map[string]interface{})
+		names[strings.ToLower(rule["name"].(string))] = true
+	}
+
+	return names
+}
+
+func resourceArmNetworkSecurityGroupRulesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*ArmClient).secGroupClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseNetworkSecurityGroupRulesID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	resGroup := id.ResourceGroup
+	nsgName := id.Path["networkSecurityGroups"]
+
+	resp, err := client.Get(ctx, resGroup, nsgName, "")
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): %s", nsgName, resGroup, azure.FormatError(err))
+	}
+
+	// when importing all of the Security Rules within the Network Security Group become managed by this resource
+	rules := make([]network.SecurityRule, 0)
+	if props := resp.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+		rules = *props.SecurityRules
+	}
+
+	if err := d.Set("security_rule", flattenNetworkSecurityRules(&rules)); err != nil {
+		return nil, fmt.Errorf("Error setting `security_rule`: %+v", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseNetworkSecurityGroupRulesID parses an ID in the format `{networkSecurityGroupId}/securityRules`
+func parseNetworkSecurityGroupRulesID(input string) (*ResourceID, error) {
+	if !strings.HasSuffix(input, "/securityRules") {
+		return nil, fmt.Errorf("Expected the ID %q to be in the format `{networkSecurityGroupId}/securityRules`", input)
+	}
+
+	id, err := parseAzureResourceID(strings.TrimSuffix(input, "/securityRules"))
+	if err != nil {
+		return nil, err
+	}
+
+	if id.Path["networkSecurityGroups"] == "" {
+		return nil, fmt.Errorf("Expected the ID %q to contain a Network Security Group", input)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,265 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestParseNetworkSecurityGroupRulesID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/securityRules",
+			Error: true,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1/securityRules",
+			Expected: "nsg1",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseNetworkSecurityGroupRulesID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if name := actual.Path["networkSecurityGroups"]; name != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, name)
+		}
+	}
+}
+
+func TestAccAzureRMNetworkSecurityGroupRules_basic(t *testing.T) {
+	resourceName := "azurerm_network_security_group_rules.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkSecurityGroupRules_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkSecurityGroupRulesExist(resourceName, "allow-http", "allow-https"),
+					resource.TestCheckResourceAttr(resourceName, "security_rule.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMNetworkSecurityGroupRules_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkSecurityGroupRulesExist(resourceName, "allow-https", "allow-ssh", "deny-all"),
+					resource.TestCheckResourceAttr(resourceName, "security_rule.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNetworkSecurityGroupRules_withStandaloneRule(t *testing.T) {
+	resourceName := "azurerm_network_security_group_rules.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkSecurityGroupRules_withStandaloneRule(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkSecurityGroupRulesExist(resourceName, "allow-http", "allow-https", "standalone"),
+					resource.TestCheckResourceAttr(resourceName, "security_rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkSecurityGroupRulesExist(resourceName string, ruleNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		nsgName := rs.Primary.Attributes["network_security_group_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).secGroupClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, nsgName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on secGroupClient: %+v", err)
+		}
+
+		existing := make(map[string]bool)
+		if props := resp.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+			for _, rule := range *props.SecurityRules {
+				existing[strings.ToLower(*rule.Name)] = true
+			}
+		}
+
+		if len(existing) != len(ruleNames) {
+			return fmt.Errorf("Bad: expected %d Security Rules in Network Security Group %q (Resource Group %q) but got %d", len(ruleNames), nsgName, resourceGroup, len(existing))
+		}
+
+		for _, name := range ruleNames {
+			if !existing[strings.ToLower(name)] {
+				return fmt.Errorf("Bad: Security Rule %q was not found in Network Security Group %q (Resource Group %q)", name, nsgName, resourceGroup)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMNetworkSecurityGroupRules_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMNetworkSecurityGroupRules_basic(rInt int, location string) string {
+	template := testAccAzureRMNetworkSecurityGroupRules_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_group_rules" "test" {
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  network_security_group_name = "${azurerm_network_security_group.test.name}"
+
+  security_rule {
+    name                       = "allow-http"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "80"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "allow-https"
+    priority                   = 101
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMNetworkSecurityGroupRules_updated(rInt int, location string) string {
+	template := testAccAzureRMNetworkSecurityGroupRules_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_group_rules" "test" {
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  network_security_group_name = "${azurerm_network_security_group.test.name}"
+
+  security_rule {
+    name                       = "allow-https"
+    priority                   = 101
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "allow-ssh"
+    priority                   = 102
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "22"
+    source_address_prefix      = "10.0.0.0/8"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "deny-all"
+    priority                   = 4096
+    direction                  = "Inbound"
+    access                     = "Deny"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMNetworkSecurityGroupRules_withStandaloneRule(rInt int, location string) string {
+	template := testAccAzureRMNetworkSecurityGroupRules_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_rule" "test" {
+  name                        = "standalone"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  network_security_group_name = "${azurerm_network_security_group.test.name}"
+  priority                    = 200
+  direction                   = "Outbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "*"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+}
+`, template)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -141,7 +142,7 @@ func resourceArmNotificationHubCreateUpdate(d *schema.ResourceData, meta interfa
 		existing, err := client.Get(ctx, resourceGroup, namespaceName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Notification Hub %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Notification Hub %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -171,7 +172,7 @@ func resourceArmNotificationHubCreateUpdate(d *schema.ResourceData, meta interfa
 	}
 
 	if _, err = client.CreateOrUpdate(ctx, resourceGroup, namespaceName, name, parameters); err != nil {
-		return fmt.Errorf("Error creating Notification Hub %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+		return fmt.Errorf("Error creating Notification Hub %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
 	}
 
 	// Notification Hubs are eventually consistent
@@ -190,7 +191,7 @@ func resourceArmNotificationHubCreateUpdate(d *schema.ResourceData, meta interfa
 
 	read, err := client.Get(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Notification Hub %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Notification Hub %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -210,7 +211,7 @@ func notificationHubStateRefreshFunc(ctx context.Context, client notificationhub
 				return nil, "404", nil
 			}
 
-			return nil, "", fmt.Errorf("Error retrieving Notification Hub %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+			return nil, "", fmt.Errorf("Error retrieving Notification Hub %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
 		}
 
 		return res, strconv.Itoa(res.StatusCode), nil
@@ -237,12 +238,12 @@ func resourceArmNotificationHubRead(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Notification Hub %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Notification Hub %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
 	}
 
 	credentials, err := client.GetPnsCredentials(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Credentials for Notification Hub %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Credentials for Notification Hub %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -282,7 +283,7 @@ func resourceArmNotificationHubDelete(d *schema.ResourceData, meta interface{})
 	resp, err := client.Delete(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error deleting Notification Hub %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+			return fmt.Errorf("Error deleting Notification Hub %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
 		}
 	}
 
@@ -16,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -43,9 +45,10 @@ func resourceArmPolicyAssignment() *schema.Resource {
 			},
 
 			"policy_definition_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"description": {
@@ -119,7 +122,7 @@ func resourceArmPolicyAssignmentCreateOrUpdate(d *schema.ResourceData, meta inte
 		existing, err := client.Get(ctx, scope, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Policy Assignment %q: %s", name, err)
+				return fmt.Errorf("Error checking for presence of existing Policy Assignment %q: %s", name, azure.FormatError(err))
 			}
 		}
 
@@ -164,7 +167,7 @@ func resourceArmPolicyAssignmentCreateOrUpdate(d *schema.ResourceData, meta inte
 	}
 
 	if _, err := client.Create(ctx, scope, name, assignment); err != nil {
-		return err
+		return fmt.Errorf("Error creating Policy Assignment %q (Scope %q): %s", name, scope, azure.FormatError(err))
 	}
 
 	// Policy Assignments are eventually consistent; wait for them to stabilize
@@ -206,7 +209,7 @@ func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Policy Assignment %q: %+v", id, err)
+		return fmt.Errorf("Error reading Policy Assignment %q: %s", id, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -253,7 +256,7 @@ func resourceArmPolicyAssignmentDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Policy Assignment %q: %+v", id, err)
+		return fmt.Errorf("Error deleting Policy Assignment %q: %s", id, azure.FormatError(err))
 	}
 
 	return nil
@@ -263,7 +266,7 @@ func policyAssignmentRefreshFunc(ctx context.Context, client policy.AssignmentsC
 	return func() (interface{}, string, error) {
 		res, err := client.Get(ctx, scope, name)
 		if err != nil {
-			return nil, strconv.Itoa(res.StatusCode), fmt.Errorf("Error issuing read request in policyAssignmentRefreshFunc for Policy Assignment %q (Scope: %q): %s", name, scope, err)
+			return nil, strconv.Itoa(res.StatusCode), fmt.Errorf("Error issuing read request in policyAssignmentRefreshFunc for Policy Assignment %q (Scope: %q): %s", name, scope, azure.FormatError(err))
 		}
 
 		return res, strconv.Itoa(res.StatusCode), nil
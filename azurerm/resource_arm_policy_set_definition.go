@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -176,7 +177,7 @@ func resourceArmPolicySetDefinitionCreateUpdate(d *schema.ResourceData, meta int
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error creating/updating Policy Set Definition %q: %s", name, err)
+		return fmt.Errorf("Error creating/updating Policy Set Definition %q: %s", name, azure.FormatError(err))
 	}
 
 	// Policy Definitions are eventually consistent; wait for them to stabilize
@@ -293,7 +294,7 @@ func resourceArmPolicySetDefinitionDelete(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Policy Set Definition %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Policy Set Definition %q: %s", name, azure.FormatError(err))
 	}
 
 	return nil
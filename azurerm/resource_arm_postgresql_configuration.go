@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -102,7 +103,7 @@ func resourceArmPostgreSQLConfigurationRead(d *schema.ResourceData, meta interfa
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Azure PostgreSQL Configuration %s: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Azure PostgreSQL Configuration %s: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -128,7 +129,7 @@ func resourceArmPostgreSQLConfigurationDelete(d *schema.ResourceData, meta inter
 	// "delete" = resetting this to the default value
 	resp, err := client.Get(ctx, resGroup, serverName, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Postgresql Configuration '%s': %+v", name, err)
+		return fmt.Errorf("Error retrieving Postgresql Configuration '%s': %s", name, azure.FormatError(err))
 	}
 
 	properties := postgresql.Configuration{
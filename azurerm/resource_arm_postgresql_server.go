@@ -4,13 +4,16 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -112,16 +115,44 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 
 			"administrator_login": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
 			"administrator_login_password": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
 
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(postgresql.CreateModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(postgresql.CreateModeDefault),
+					string(postgresql.CreateModeGeoRestore),
+					string(postgresql.CreateModePointInTimeRestore),
+				}, false),
+			},
+
+			"creation_source_server_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"restore_point_in_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+
 			"version": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -177,6 +208,8 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"threat_detection_policy": azure.SchemaDatabaseServerThreatDetectionPolicy(),
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -194,7 +227,7 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 				return fmt.Errorf("basic pricing tier only supports upto 1,048,576 MB (1TB) of storage")
 			}
 
-			return nil
+			return validateDatabaseServerCreateMode(diff)
 		},
 	}
 }
@@ -209,18 +242,13 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	adminLogin := d.Get("administrator_login").(string)
-	adminLoginPassword := d.Get("administrator_login_password").(string)
-	sslEnforcement := d.Get("ssl_enforcement").(string)
-	version := d.Get("version").(string)
-	createMode := "Default"
 	tags := d.Get("tags").(map[string]interface{})
 
 	if requireResourcesToBeImported {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -230,34 +258,31 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	sku := expandAzureRmPostgreSQLServerSku(d)
-	storageProfile := expandAzureRmPostgreSQLStorageProfile(d)
+
+	createProperties, err := expandAzureRmPostgreSQLServerPropertiesForCreate(d)
+	if err != nil {
+		return err
+	}
 
 	properties := postgresql.ServerForCreate{
-		Location: &location,
-		Properties: &postgresql.ServerPropertiesForDefaultCreate{
-			AdministratorLogin:         utils.String(adminLogin),
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    postgresql.ServerVersion(version),
-			SslEnforcement:             postgresql.SslEnforcementEnum(sslEnforcement),
-			StorageProfile:             storageProfile,
-			CreateMode:                 postgresql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: expandTags(tags),
+		Location:   &location,
+		Properties: createProperties,
+		Sku:        sku,
+		Tags:       expandTags(tags),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error creating PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for creation of PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -266,6 +291,12 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("threat_detection_policy") {
+		if err := updatePostgreSQLServerSecurityAlertPolicy(d, meta, resourceGroup, name); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmPostgreSQLServerRead(d, meta)
 }
 
@@ -287,27 +318,31 @@ func resourceArmPostgreSQLServerUpdate(d *schema.ResourceData, meta interface{})
 
 	properties := postgresql.ServerUpdateParameters{
 		ServerUpdateParametersProperties: &postgresql.ServerUpdateParametersProperties{
-			StorageProfile:             storageProfile,
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    postgresql.ServerVersion(version),
-			SslEnforcement:             postgresql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			Version:        postgresql.ServerVersion(version),
+			SslEnforcement: postgresql.SslEnforcementEnum(sslEnforcement),
 		},
 		Sku:  sku,
 		Tags: expandTags(tags),
 	}
 
+	// the password is inherited from the source server when it's created as a Replica or restored from a backup
+	if adminLoginPassword != "" {
+		properties.ServerUpdateParametersProperties.AdministratorLoginPassword = utils.String(adminLoginPassword)
+	}
+
 	future, err := client.Update(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error updating PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error updating PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for update of PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for update of PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if read.ID == nil {
@@ -316,6 +351,12 @@ func resourceArmPostgreSQLServerUpdate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("threat_detection_policy") {
+		if err := updatePostgreSQLServerSecurityAlertPolicy(d, meta, resourceGroup, name); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmPostgreSQLServerRead(d, meta)
 }
 
@@ -338,7 +379,7 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Azure PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Azure PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -349,6 +390,11 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.Set("administrator_login", resp.AdministratorLogin)
+
+	// the Create Mode isn't returned by the API - so if it's not already set (e.g. when importing) assume the default
+	if _, ok := d.GetOk("create_mode"); !ok {
+		d.Set("create_mode", string(postgresql.CreateModeDefault))
+	}
 	d.Set("version", string(resp.Version))
 	d.Set("ssl_enforcement", string(resp.SslEnforcement))
 
@@ -362,6 +408,15 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 
 	flattenAndSetTags(d, resp.Tags)
 
+	// Threat Detection isn't supported for all SKU's, so we only log any error when retrieving the Policy
+	securityAlertPoliciesClient := meta.(*ArmClient).postgresqlServerSecurityAlertPoliciesClient
+	policy, err := securityAlertPoliciesClient.Get(ctx, resourceGroup, name)
+	if err != nil {
+		log.Printf("[WARN] Error retrieving Threat Detection Policy for PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	} else if err := d.Set("threat_detection_policy", flattenPostgreSQLServerSecurityAlertPolicy(d, policy.SecurityAlertPolicyProperties)); err != nil {
+		return fmt.Errorf("Error setting `threat_detection_policy`: %+v", err)
+	}
+
 	// Computed
 	d.Set("fqdn", resp.FullyQualifiedDomainName)
 
@@ -385,7 +440,7 @@ func resourceArmPostgreSQLServerDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error deleting PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
@@ -393,12 +448,65 @@ func resourceArmPostgreSQLServerDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error waiting for deletion of PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for deletion of PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	return nil
 }
 
+func expandAzureRmPostgreSQLServerPropertiesForCreate(d *schema.ResourceData) (postgresql.BasicServerPropertiesForCreate, error) {
+	adminLogin := d.Get("administrator_login").(string)
+	adminLoginPassword := d.Get("administrator_login_password").(string)
+	createMode := postgresql.CreateMode(d.Get("create_mode").(string))
+	sourceServerId := d.Get("creation_source_server_id").(string)
+	restorePointInTime := d.Get("restore_point_in_time").(string)
+	sslEnforcement := postgresql.SslEnforcementEnum(d.Get("ssl_enforcement").(string))
+	storageProfile := expandAzureRmPostgreSQLStorageProfile(d)
+	version := postgresql.ServerVersion(d.Get("version").(string))
+
+	// the fields required by each `create_mode` are validated in the CustomizeDiff - however since `administrator_login`
+	// is Computed, it's not possible to tell there whether it's been omitted, so this is checked again here
+	if createMode == postgresql.CreateModeDefault {
+		if adminLogin == "" || adminLoginPassword == "" {
+			return nil, fmt.Errorf("`administrator_login` and `administrator_login_password` must be specified when `create_mode` is `Default`")
+		}
+
+		return &postgresql.ServerPropertiesForDefaultCreate{
+			AdministratorLogin:         utils.String(adminLogin),
+			AdministratorLoginPassword: utils.String(adminLoginPassword),
+			Version:                    version,
+			SslEnforcement:             sslEnforcement,
+			StorageProfile:             storageProfile,
+			CreateMode:                 createMode,
+		}, nil
+	}
+
+	switch createMode {
+	case postgresql.CreateModeGeoRestore:
+		return &postgresql.ServerPropertiesForGeoRestore{
+			SourceServerID: utils.String(sourceServerId),
+			Version:        version,
+			SslEnforcement: sslEnforcement,
+			StorageProfile: storageProfile,
+			CreateMode:     createMode,
+		}, nil
+
+	case postgresql.CreateModePointInTimeRestore:
+		// this has already been validated by the schema
+		restoreTime, _ := date.ParseTime(time.RFC3339, restorePointInTime)
+		return &postgresql.ServerPropertiesForRestore{
+			SourceServerID:     utils.String(sourceServerId),
+			RestorePointInTime: &date.Time{Time: restoreTime},
+			Version:            version,
+			SslEnforcement:     sslEnforcement,
+			StorageProfile:     storageProfile,
+			CreateMode:         createMode,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported `create_mode` %q", string(createMode))
+}
+
 func expandAzureRmPostgreSQLServerSku(d *schema.ResourceData) *postgresql.Sku {
 	skus := d.Get("sku").([]interface{})
 	sku := skus[0].(map[string]interface{})
@@ -466,3 +574,86 @@ func flattenPostgreSQLStorageProfile(resp *postgresql.StorageProfile) []interfac
 
 	return []interface{}{values}
 }
+
+func updatePostgreSQLServerSecurityAlertPolicy(d *schema.ResourceData, meta interface{}, resourceGroup, name string) error {
+	client := meta.(*ArmClient).postgresqlServerSecurityAlertPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] Updating Threat Detection Policy for PostgreSQL Server %q (Resource Group %q)", name, resourceGroup)
+
+	policy := expandPostgreSQLServerSecurityAlertPolicy(d)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, policy)
+	if err != nil {
+		return fmt.Errorf("Error updating Threat Detection Policy for PostgreSQL Server %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Threat Detection Policy for PostgreSQL Server %q (Resource Group %q) to finish updating: %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
+func expandPostgreSQLServerSecurityAlertPolicy(d *schema.ResourceData) postgresql.ServerSecurityAlertPolicy {
+	policy := azure.ExpandDatabaseServerThreatDetectionPolicy(d.Get("threat_detection_policy").([]interface{}))
+
+	state := postgresql.ServerSecurityAlertPolicyStateDisabled
+	if policy.Enabled {
+		state = postgresql.ServerSecurityAlertPolicyStateEnabled
+	}
+
+	properties := postgresql.SecurityAlertPolicyProperties{
+		State:              state,
+		DisabledAlerts:     &policy.DisabledAlerts,
+		EmailAccountAdmins: utils.Bool(policy.EmailAccountAdmins),
+		EmailAddresses:     &policy.EmailAddresses,
+		RetentionDays:      utils.Int32(int32(policy.RetentionDays)),
+	}
+
+	if policy.StorageAccountAccessKey != "" {
+		properties.StorageAccountAccessKey = utils.String(policy.StorageAccountAccessKey)
+	}
+
+	if policy.StorageEndpoint != "" {
+		properties.StorageEndpoint = utils.String(policy.StorageEndpoint)
+	}
+
+	return postgresql.ServerSecurityAlertPolicy{
+		SecurityAlertPolicyProperties: &properties,
+	}
+}
+
+func flattenPostgreSQLServerSecurityAlertPolicy(d *schema.ResourceData, input *postgresql.SecurityAlertPolicyProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	policy := azure.DatabaseServerThreatDetectionPolicy{
+		Enabled: input.State == postgresql.ServerSecurityAlertPolicyStateEnabled,
+	}
+
+	if input.DisabledAlerts != nil {
+		policy.DisabledAlerts = *input.DisabledAlerts
+	}
+
+	if input.EmailAccountAdmins != nil {
+		policy.EmailAccountAdmins = *input.EmailAccountAdmins
+	}
+
+	if input.EmailAddresses != nil {
+		policy.EmailAddresses = *input.EmailAddresses
+	}
+
+	if input.RetentionDays != nil {
+		policy.RetentionDays = int(*input.RetentionDays)
+	}
+
+	if input.StorageEndpoint != nil {
+		policy.StorageEndpoint = *input.StorageEndpoint
+	}
+
+	// the Storage Account Access Key isn't returned by the API, so we pull this from the state
+	storageAccountAccessKey := d.Get("threat_detection_policy.0.storage_account_access_key").(string)
+
+	return azure.FlattenDatabaseServerThreatDetectionPolicy(policy, storageAccountAccessKey)
+}
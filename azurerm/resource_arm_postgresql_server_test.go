@@ -3,7 +3,9 @@ package azurerm
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -326,6 +328,80 @@ func TestAccAzureRMPostgreSQLServer_updateSKU(t *testing.T) {
 
 //
 
+func TestAccAzureRMPostgreSQLServer_createPointInTimeRestore(t *testing.T) {
+	resourceName := "azurerm_postgresql_server.test"
+	restoreResourceName := "azurerm_postgresql_server.restore"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	restoreTime := time.Now().Add(11 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPostgreSQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPostgreSQLServer_generalPurpose(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+				),
+			},
+			{
+				// the restore point has to be after the earliest restore date of the source server
+				PreConfig: func() { time.Sleep(time.Until(restoreTime)) },
+				Config:    testAccAzureRMPostgreSQLServer_createPointInTimeRestore(ri, location, restoreTime.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+					testCheckAzureRMPostgreSQLServerExists(restoreResourceName),
+					resource.TestCheckResourceAttr(restoreResourceName, "administrator_login", "acctestun"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPostgreSQLServer_threatDetectionPolicy(t *testing.T) {
+	resourceName := "azurerm_postgresql_server.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPostgreSQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPostgreSQLServer_threatDetectionPolicy(ri, rs, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.disabled_alerts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.email_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.retention_days", "15"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"administrator_login_password",                         // not returned as sensitive
+					"threat_detection_policy.0.storage_account_access_key", // not returned as sensitive
+				},
+			},
+			{
+				Config: testAccAzureRMPostgreSQLServer_threatDetectionPolicy(ri, rs, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMPostgreSQLServerExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -710,3 +786,91 @@ resource "azurerm_postgresql_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMPostgreSQLServer_createPointInTimeRestore(rInt int, location, restoreTime string) string {
+	template := testAccAzureRMPostgreSQLServer_generalPurpose(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_server" "restore" {
+  name                = "acctestpsqlsvr-%d-restore"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_32"
+    capacity = 32
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 640000
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  create_mode               = "PointInTimeRestore"
+  creation_source_server_id = "${azurerm_postgresql_server.test.id}"
+  restore_point_in_time     = "%s"
+  version                   = "9.6"
+  ssl_enforcement           = "Enabled"
+}
+`, template, rInt, restoreTime)
+}
+
+func testAccAzureRMPostgreSQLServer_threatDetectionPolicy(rInt int, rString string, location string, enabled bool) string {
+	threatDetectionPolicy := ""
+	if enabled {
+		threatDetectionPolicy = `
+  threat_detection_policy {
+    disabled_alerts            = ["Sql_Injection"]
+    email_account_admins       = true
+    email_addresses            = ["security@example.com", "admin@example.com"]
+    retention_days             = 15
+    storage_endpoint           = "${azurerm_storage_account.test.primary_blob_endpoint}"
+    storage_account_access_key = "${azurerm_storage_account.test.primary_access_key}"
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_postgresql_server" "test" {
+  name                = "acctestpsqlsvr-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_2"
+    capacity = 2
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  administrator_login          = "acctestun"
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "9.6"
+  ssl_enforcement              = "Enabled"
+%s
+}
+`, rInt, location, rString, rInt, threatDetectionPolicy)
+}
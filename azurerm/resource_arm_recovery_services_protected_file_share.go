@@ -0,0 +1,298 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRecoveryServicesProtectedFileShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRecoveryServicesProtectedFileShareCreateUpdate,
+		Read:   resourceArmRecoveryServicesProtectedFileShareRead,
+		Update: resourceArmRecoveryServicesProtectedFileShareCreateUpdate,
+		Delete: resourceArmRecoveryServicesProtectedFileShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"source_storage_account_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"source_file_share_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"backup_policy_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+		},
+	}
+}
+
+func resourceArmRecoveryServicesProtectedFileShareCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectedItemsClient
+	containersClient := meta.(*ArmClient).recoveryServicesProtectionContainersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	storageAccountId := d.Get("source_storage_account_id").(string)
+	fileShareName := d.Get("source_file_share_name").(string)
+	policyId := d.Get("backup_policy_id").(string)
+
+	parsedStorageAccountId, err := azure.ParseAzureResourceID(storageAccountId)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Unable to parse source_storage_account_id '%s': %+v", storageAccountId, err)
+	}
+	accountName, hasName := parsedStorageAccountId.Path["storageAccounts"]
+	if !hasName {
+		return fmt.Errorf("[ERROR] parsed source_storage_account_id '%s' doesn't contain 'storageAccounts'", storageAccountId)
+	}
+
+	protectedItemName := fmt.Sprintf("AzureFileShare;%s", fileShareName)
+	containerName := fmt.Sprintf("StorageContainer;storage;%s;%s", parsedStorageAccountId.ResourceGroup, accountName)
+
+	log.Printf("[DEBUG] Creating/updating Recovery Service Protected File Share %s (resource group %q)", protectedItemName, resourceGroup)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err2 := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+		if err2 != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Recovery Service Protected File Share %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err2)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_recovery_services_protected_file_share", *existing.ID)
+		}
+	}
+
+	// the Storage Account has to be registered with the Vault before any File Shares within it can be protected
+	// registering an already registered Storage Account is a no-op
+	container := backup.ProtectionContainerResource{
+		Properties: &backup.AzureStorageContainer{
+			SourceResourceID:     utils.String(storageAccountId),
+			FriendlyName:         utils.String(accountName),
+			BackupManagementType: backup.ManagementTypeAzureStorage,
+			ContainerType:        backup.ContainerTypeStorageContainer1,
+		},
+	}
+	if _, err = containersClient.Register(ctx, vaultName, resourceGroup, "Azure", containerName, container); err != nil {
+		return fmt.Errorf("Error registering Storage Account %q with Recovery Service Vault %q (Resource Group %q): %s", accountName, vaultName, resourceGroup, azure.FormatError(err))
+	}
+
+	if err = resourceArmRecoveryServicesProtectedFileShareWaitForContainer(containersClient, ctx, vaultName, resourceGroup, containerName); err != nil {
+		return err
+	}
+
+	item := backup.ProtectedItemResource{
+		Properties: &backup.AzureFileshareProtectedItem{
+			PolicyID:          utils.String(policyId),
+			ProtectedItemType: backup.ProtectedItemTypeAzureFileShareProtectedItem,
+			WorkloadType:      backup.DataSourceTypeAzureFileShare,
+			SourceResourceID:  utils.String(storageAccountId),
+			FriendlyName:      utils.String(fileShareName),
+		},
+	}
+
+	if _, err = client.CreateOrUpdate(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, item); err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Protected File Share %q (Resource Group %q): %s", protectedItemName, resourceGroup, azure.FormatError(err))
+	}
+
+	resp, err := resourceArmRecoveryServicesProtectedFileShareWaitForState(client, ctx, true, vaultName, resourceGroup, containerName, protectedItemName)
+	if err != nil {
+		return err
+	}
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmRecoveryServicesProtectedFileShareRead(d, meta)
+}
+
+func resourceArmRecoveryServicesProtectedFileShareRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectedItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protectedItemName := id.Path["protectedItems"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+	containerName := id.Path["protectionContainers"]
+
+	log.Printf("[DEBUG] Reading Recovery Service Protected File Share %q (resource group %q)", protectedItemName, resourceGroup)
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Recovery Service Protected File Share %q (Resource Group %q): %s", protectedItemName, resourceGroup, azure.FormatError(err))
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties := resp.Properties; properties != nil {
+		if fileShare, ok := properties.AsAzureFileshareProtectedItem(); ok {
+			d.Set("source_storage_account_id", fileShare.SourceResourceID)
+			d.Set("source_file_share_name", fileShare.FriendlyName)
+
+			if v := fileShare.PolicyID; v != nil {
+				d.Set("backup_policy_id", strings.Replace(*v, "Subscriptions", "subscriptions", 1))
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmRecoveryServicesProtectedFileShareDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectedItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protectedItemName := id.Path["protectedItems"]
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	containerName := id.Path["protectionContainers"]
+
+	log.Printf("[DEBUG] Deleting Recovery Service Protected File Share %q (resource group %q)", protectedItemName, resourceGroup)
+
+	resp, err := client.Delete(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error issuing delete request for Recovery Service Protected File Share %q (Resource Group %q): %s", protectedItemName, resourceGroup, azure.FormatError(err))
+		}
+	}
+
+	if _, err := resourceArmRecoveryServicesProtectedFileShareWaitForState(client, ctx, false, vaultName, resourceGroup, containerName, protectedItemName); err != nil {
+		return err
+	}
+
+	// the Storage Account is intentionally left registered with the Vault, since other File Shares within it may still be protected
+
+	return nil
+}
+
+func resourceArmRecoveryServicesProtectedFileShareWaitForContainer(client backup.ProtectionContainersClient, ctx context.Context, vaultName, resourceGroup, containerName string) error {
+	state := &resource.StateChangeConf{
+		Timeout:    30 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Pending:    []string{"NotFound", "NotRegistered", "Registering"},
+		Target:     []string{"Registered"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return resp, "Error", fmt.Errorf("Error making Read request on Recovery Service Protection Container %q (Resource Group %q): %s", containerName, resourceGroup, azure.FormatError(err))
+			}
+
+			if properties := resp.Properties; properties != nil {
+				if container, ok := properties.AsAzureStorageContainer(); ok && container.RegistrationStatus != nil {
+					return resp, *container.RegistrationStatus, nil
+				}
+			}
+
+			return resp, "Registering", nil
+		},
+	}
+
+	if _, err := state.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the Recovery Service Protection Container %q to be registered (Resource Group %q): %+v", containerName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func resourceArmRecoveryServicesProtectedFileShareWaitForState(client backup.ProtectedItemsGroupClient, ctx context.Context, found bool, vaultName, resourceGroup, containerName, protectedItemName string) (backup.ProtectedItemResource, error) {
+	state := &resource.StateChangeConf{
+		Timeout:    30 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return resp, "Error", fmt.Errorf("Error making Read request on Recovery Service Protected File Share %q (Resource Group %q): %s", protectedItemName, resourceGroup, azure.FormatError(err))
+			}
+
+			return resp, "Found", nil
+		},
+	}
+
+	if found {
+		state.Pending = []string{"NotFound"}
+		state.Target = []string{"Found"}
+	} else {
+		state.Pending = []string{"Found"}
+		state.Target = []string{"NotFound"}
+	}
+
+	resp, err := state.WaitForState()
+	if err != nil {
+		i, _ := resp.(backup.ProtectedItemResource)
+		return i, fmt.Errorf("Error waiting for the Recovery Service Protected File Share %q to be %t (Resource Group %q) to provision: %+v", protectedItemName, found, resourceGroup, err)
+	}
+
+	return resp.(backup.ProtectedItemResource), nil
+}
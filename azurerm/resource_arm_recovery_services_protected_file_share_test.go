@@ -0,0 +1,223 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRecoveryServicesProtectedFileShare_basic(t *testing.T) {
+	resourceName := "azurerm_recovery_services_protected_file_share.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesProtectedFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesProtectedFileShare_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectedFileShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_file_share_name", "testshare"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRecoveryServicesProtectedFileShare_updatePolicy(t *testing.T) {
+	resourceName := "azurerm_recovery_services_protected_file_share.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesProtectedFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesProtectedFileShare_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectedFileShareExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "backup_policy_id", "azurerm_recovery_services_protection_policy_file_share.test", "id"),
+				),
+			},
+			{
+				Config: testAccAzureRMRecoveryServicesProtectedFileShare_updatePolicy(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectedFileShareExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "backup_policy_id", "azurerm_recovery_services_protection_policy_file_share.test2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRecoveryServicesProtectedFileShareDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServicesProtectedItemsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_recovery_services_protected_file_share" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		vaultName := id.Path["vaults"]
+		containerName := id.Path["protectionContainers"]
+		protectedItemName := id.Path["protectedItems"]
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Recovery Services Protected File Share still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRecoveryServicesProtectedFileShareExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).recoveryServicesProtectedItemsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		vaultName := id.Path["vaults"]
+		containerName := id.Path["protectionContainers"]
+		protectedItemName := id.Path["protectedItems"]
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Recovery Services Protected File Share %q (resource group: %q) was not found: %+v", protectedItemName, resourceGroup, err)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesProtectedItemsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRecoveryServicesProtectedFileShare_base(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctest%[3]s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+
+resource "azurerm_recovery_services_protection_policy_file_share" "test" {
+  name                = "acctest-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+
+resource "azurerm_recovery_services_protection_policy_file_share" "test2" {
+  name                = "acctest2-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "22:00"
+  }
+
+  retention_daily {
+    count = 30
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMRecoveryServicesProtectedFileShare_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_recovery_services_protected_file_share" "test" {
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  recovery_vault_name       = "${azurerm_recovery_services_vault.test.name}"
+  source_storage_account_id = "${azurerm_storage_account.test.id}"
+  source_file_share_name    = "${azurerm_storage_share.test.name}"
+  backup_policy_id          = "${azurerm_recovery_services_protection_policy_file_share.test.id}"
+}
+`, testAccAzureRMRecoveryServicesProtectedFileShare_base(rInt, rString, location))
+}
+
+func testAccAzureRMRecoveryServicesProtectedFileShare_updatePolicy(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_recovery_services_protected_file_share" "test" {
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  recovery_vault_name       = "${azurerm_recovery_services_vault.test.name}"
+  source_storage_account_id = "${azurerm_storage_account.test.id}"
+  source_file_share_name    = "${azurerm_storage_share.test.name}"
+  backup_policy_id          = "${azurerm_recovery_services_protection_policy_file_share.test2.id}"
+}
+`, testAccAzureRMRecoveryServicesProtectedFileShare_base(rInt, rString, location))
+}
@@ -0,0 +1,173 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRecoveryServicesProtectionPolicyFileShare_basicDaily(t *testing.T) {
+	resourceName := "azurerm_recovery_services_protection_policy_file_share.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesProtectionPolicyFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesProtectionPolicyFileShare_basicDaily(ri, testLocation()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectionPolicyFileShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.frequency", "Daily"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.time", "23:00"),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRecoveryServicesProtectionPolicyFileShare_updateDaily(t *testing.T) {
+	resourceName := "azurerm_recovery_services_protection_policy_file_share.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesProtectionPolicyFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesProtectionPolicyFileShare_basicDaily(ri, testLocation()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectionPolicyFileShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "10"),
+				),
+			},
+			{
+				Config: testAccAzureRMRecoveryServicesProtectionPolicyFileShare_updateDaily(ri, testLocation()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectionPolicyFileShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.time", "22:30"),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRecoveryServicesProtectionPolicyFileShareDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServicesProtectionPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_recovery_services_protection_policy_file_share" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		policyName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Recovery Services Vault Policy still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRecoveryServicesProtectionPolicyFileShareExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).recoveryServicesProtectionPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		policyName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Recovery Services Vault %q Policy: %q", vaultName, policyName)
+		}
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Recovery Services Vault Policy %q (resource group: %q) was not found: %+v", policyName, resourceGroup, err)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesProtectionPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRecoveryServicesProtectionPolicyFileShare_basicDaily(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_recovery_services_protection_policy_file_share" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+`, testAccAzureRMRecoveryServicesProtectionPolicyVm_base(rInt, location), rInt)
+}
+
+func testAccAzureRMRecoveryServicesProtectionPolicyFileShare_updateDaily(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_recovery_services_protection_policy_file_share" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  timezone            = "UTC"
+
+  backup {
+    frequency = "Daily"
+    time      = "22:30"
+  }
+
+  retention_daily {
+    count = 30
+  }
+}
+`, testAccAzureRMRecoveryServicesProtectionPolicyVm_base(rInt, location), rInt)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -325,7 +326,7 @@ func resourceArmRecoveryServicesProtectionPolicyVmCreateUpdate(d *schema.Resourc
 		},
 	}
 	if _, err = client.CreateOrUpdate(ctx, vaultName, resourceGroup, policyName, policy); err != nil {
-		return fmt.Errorf("Error creating/updating Recovery Service Protection Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+		return fmt.Errorf("Error creating/updating Recovery Service Protection Policy %q (Resource Group %q): %s", policyName, resourceGroup, azure.FormatError(err))
 	}
 
 	resp, err := resourceArmRecoveryServicesProtectionPolicyWaitForState(client, ctx, true, vaultName, resourceGroup, policyName)
@@ -361,7 +362,7 @@ func resourceArmRecoveryServicesProtectionPolicyVmRead(d *schema.ResourceData, m
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Recovery Service Protection Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Recovery Service Protection Policy %q (Resource Group %q): %s", policyName, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", policyName)
@@ -437,7 +438,7 @@ func resourceArmRecoveryServicesProtectionPolicyVmDelete(d *schema.ResourceData,
 	resp, err := client.Delete(ctx, vaultName, resourceGroup, policyName)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error issuing delete request for Recovery Service Protection Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+			return fmt.Errorf("Error issuing delete request for Recovery Service Protection Policy %q (Resource Group %q): %s", policyName, resourceGroup, azure.FormatError(err))
 		}
 	}
 
@@ -714,7 +715,7 @@ func resourceArmRecoveryServicesProtectionPolicyWaitForState(client backup.Prote
 					return resp, "NotFound", nil
 				}
 
-				return resp, "Error", fmt.Errorf("Error making Read request on Recovery Service Protection Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+				return resp, "Error", fmt.Errorf("Error making Read request on Recovery Service Protection Policy %q (Resource Group %q): %s", policyName, resourceGroup, azure.FormatError(err))
 			}
 
 			return resp, "Found", nil
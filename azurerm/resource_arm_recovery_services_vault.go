@@ -8,9 +8,11 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -51,12 +53,24 @@ func resourceArmRecoveryServicesVault() *schema.Resource {
 					string(recoveryservices.Standard),
 				}, true),
 			},
+
+			"storage_mode_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(backup.StorageTypeGeoRedundant),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(backup.StorageTypeGeoRedundant),
+					string(backup.StorageTypeLocallyRedundant),
+				}, true),
+			},
 		},
 	}
 }
 
 func resourceArmRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).recoveryServicesVaultsClient
+	storageConfigsClient := meta.(*ArmClient).recoveryServicesStorageConfigsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -70,7 +84,7 @@ func resourceArmRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta i
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Recovery Service Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -92,7 +106,20 @@ func resourceArmRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta i
 	//create recovery services vault
 	vault, err := client.CreateOrUpdate(ctx, resourceGroup, name, vault)
 	if err != nil {
-		return fmt.Errorf("Error creating/updating Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating/updating Recovery Service Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	// the storage redundancy can only be changed until the first item is protected within the vault
+	if d.IsNewResource() || d.HasChange("storage_mode_type") {
+		storageConfig := backup.ResourceConfigResource{
+			Properties: &backup.ResourceConfig{
+				StorageModelType: backup.StorageType(d.Get("storage_mode_type").(string)),
+			},
+		}
+
+		if _, err := storageConfigsClient.Update(ctx, name, resourceGroup, storageConfig); err != nil {
+			return fmt.Errorf("Error updating Storage Config for Recovery Service Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+		}
 	}
 
 	d.SetId(*vault.ID)
@@ -102,6 +129,7 @@ func resourceArmRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmRecoveryServicesVaultRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).recoveryServicesVaultsClient
+	storageConfigsClient := meta.(*ArmClient).recoveryServicesStorageConfigsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	id, err := parseAzureResourceID(d.Id())
@@ -121,7 +149,7 @@ func resourceArmRecoveryServicesVaultRead(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Recovery Service Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -134,6 +162,15 @@ func resourceArmRecoveryServicesVaultRead(d *schema.ResourceData, meta interface
 		d.Set("sku", string(sku.Name))
 	}
 
+	storageConfig, err := storageConfigsClient.Get(ctx, name, resourceGroup)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Storage Config for Recovery Service Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	if props := storageConfig.Properties; props != nil {
+		d.Set("storage_mode_type", string(props.StorageModelType))
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -156,7 +193,7 @@ func resourceArmRecoveryServicesVaultDelete(d *schema.ResourceData, meta interfa
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error issuing delete request for Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error issuing delete request for Recovery Service Vault %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
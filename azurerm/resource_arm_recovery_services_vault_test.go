@@ -39,6 +39,39 @@ func TestAccAzureRMRecoveryServicesVault_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMRecoveryServicesVault_storageModeType(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_recovery_services_vault.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesVault_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode_type", "GeoRedundant"),
+				),
+			},
+			{
+				Config: testAccAzureRMRecoveryServicesVault_storageModeType(ri, location, "LocallyRedundant"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode_type", "LocallyRedundant"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMRecoveryServicesVault_requiresImport(t *testing.T) {
 	if !requireResourcesToBeImported {
 		t.Skip("Skipping since resources aren't required to be imported")
@@ -145,6 +178,23 @@ resource "azurerm_recovery_services_vault" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMRecoveryServicesVault_storageModeType(rInt int, location string, storageModeType string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+  storage_mode_type   = "%s"
+}
+`, rInt, location, rInt, storageModeType)
+}
+
 func testAccAzureRMRecoveryServicesVault_requiresImport(rInt int, location string) string {
 	return fmt.Sprintf(`
 %s
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -84,9 +85,10 @@ func resourceArmRedisCache() *schema.Resource {
 			},
 
 			"subnet_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"private_static_ip_address": {
@@ -292,7 +294,8 @@ func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error issuing create request for read Redis Cache %s (resource group %s) ID", name, resGroup)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	description := fmt.Sprintf("Redis Cache %q (Resource Group %q) to be created", name, resGroup)
+	if err = azure.WaitForCompletionWithProgress(ctx, &future.Future, client.Client, description); err != nil {
 		return fmt.Errorf("Error waiting for Redis Cache %s (resource group %s)", name, resGroup)
 	}
 
@@ -322,7 +325,7 @@ func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error
 		patchClient := meta.(*ArmClient).redisPatchSchedulesClient
 		_, err = patchClient.CreateOrUpdate(ctx, resGroup, name, *schedule)
 		if err != nil {
-			return fmt.Errorf("Error setting Redis Patch Schedule: %+v", err)
+			return fmt.Errorf("Error setting Redis Patch Schedule: %s", azure.FormatError(err))
 		}
 	}
 
@@ -405,12 +408,12 @@ func resourceArmRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error
 	if patchSchedule == nil || len(*patchSchedule.ScheduleEntries.ScheduleEntries) == 0 {
 		_, err = patchClient.Delete(ctx, resGroup, name)
 		if err != nil {
-			return fmt.Errorf("Error deleting Redis Patch Schedule: %+v", err)
+			return fmt.Errorf("Error deleting Redis Patch Schedule: %s", azure.FormatError(err))
 		}
 	} else {
 		_, err = patchClient.CreateOrUpdate(ctx, resGroup, name, *patchSchedule)
 		if err != nil {
-			return fmt.Errorf("Error setting Redis Patch Schedule: %+v", err)
+			return fmt.Errorf("Error setting Redis Patch Schedule: %s", azure.FormatError(err))
 		}
 	}
 
@@ -437,12 +440,12 @@ func resourceArmRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error making Read request on Azure Redis Cache %s: %s", name, err)
+		return fmt.Errorf("Error making Read request on Azure Redis Cache %s: %s", name, azure.FormatError(err))
 	}
 
 	keysResp, err := client.ListKeys(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making ListKeys request on Azure Redis Cache %s: %s", name, err)
+		return fmt.Errorf("Error making ListKeys request on Azure Redis Cache %s: %s", name, azure.FormatError(err))
 	}
 
 	patchSchedulesClient := meta.(*ArmClient).redisPatchSchedulesClient
@@ -511,7 +514,7 @@ func resourceArmRedisCacheDelete(d *schema.ResourceData, meta interface{}) error
 
 	read, err := redisClient.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Redis Cache %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Redis Cache %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 	if read.Properties == nil {
 		return fmt.Errorf("Error retrieving Redis Cache properties %q (Resource Group %q): `props` was nil", name, resGroup)
@@ -553,7 +556,7 @@ func redisStateRefreshFunc(ctx context.Context, client redis.Client, resourceGro
 	return func() (interface{}, string, error) {
 		res, err := client.Get(ctx, resourceGroupName, sgName)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error issuing read request in redisStateRefreshFunc to Azure ARM for Redis Cache Instance '%s' (RG: '%s'): %s", sgName, resourceGroupName, err)
+			return nil, "", fmt.Errorf("Error issuing read request in redisStateRefreshFunc to Azure ARM for Redis Cache Instance '%s' (RG: '%s'): %s", sgName, resourceGroupName, azure.FormatError(err))
 		}
 
 		return res, string(res.ProvisioningState), nil
@@ -0,0 +1,159 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRelayHybridConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRelayHybridConnectionCreateUpdate,
+		Read:   resourceArmRelayHybridConnectionRead,
+		Update: resourceArmRelayHybridConnectionCreateUpdate,
+		Delete: resourceArmRelayHybridConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"relay_namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(6, 50),
+			},
+
+			"requires_client_authorization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"user_metadata": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmRelayHybridConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relayHybridConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+	log.Printf("[INFO] preparing arguments for Relay Hybrid Connection creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	namespaceName := d.Get("relay_namespace_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_relay_hybrid_connection", *existing.ID)
+		}
+	}
+
+	parameters := relay.HybridConnection{
+		HybridConnectionProperties: &relay.HybridConnectionProperties{
+			RequiresClientAuthorization: utils.Bool(d.Get("requires_client_authorization").(bool)),
+			UserMetadata:                utils.String(d.Get("user_metadata").(string)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, namespaceName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Relay Hybrid Connection %q (Namespace %q / Resource Group %q) ID", name, namespaceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmRelayHybridConnectionRead(d, meta)
+}
+
+func resourceArmRelayHybridConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relayHybridConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["hybridConnections"]
+
+	resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Relay Hybrid Connection %q (Namespace %q / Resource Group %q) was not found - removing from state", name, namespaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("relay_namespace_name", namespaceName)
+
+	if props := resp.HybridConnectionProperties; props != nil {
+		d.Set("requires_client_authorization", props.RequiresClientAuthorization)
+		d.Set("user_metadata", props.UserMetadata)
+	}
+
+	return nil
+}
+
+func resourceArmRelayHybridConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relayHybridConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["hybridConnections"]
+
+	resp, err := client.Delete(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, azure.FormatError(err))
+		}
+	}
+
+	return nil
+}
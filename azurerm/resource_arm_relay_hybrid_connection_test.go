@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRelayHybridConnection_basic(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayHybridConnection_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "requires_client_authorization", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRelayHybridConnection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_relay_hybrid_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayHybridConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMRelayHybridConnection_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_relay_hybrid_connection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRelayHybridConnection_update(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayHybridConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_metadata", ""),
+				),
+			},
+			{
+				Config: testAccAzureRMRelayHybridConnection_userMetadata(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_metadata", "on-premises database"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRelayHybridConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		namespaceName := rs.Primary.Attributes["relay_namespace_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).relayHybridConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Relay Hybrid Connection %q (Namespace %q / Resource Group %q) does not exist", name, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on relayHybridConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMRelayHybridConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).relayHybridConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_relay_hybrid_connection" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		namespaceName := rs.Primary.Attributes["relay_namespace_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Relay Hybrid Connection %q (Namespace %q / Resource Group %q) still exists", name, namespaceName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMRelayHybridConnection_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMRelayHybridConnection_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctestrhc-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  relay_namespace_name = "${azurerm_relay_namespace.test.name}"
+}
+`, testAccAzureRMRelayHybridConnection_template(rInt, location), rInt)
+}
+
+func testAccAzureRMRelayHybridConnection_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_hybrid_connection" "import" {
+  name                 = "${azurerm_relay_hybrid_connection.test.name}"
+  resource_group_name  = "${azurerm_relay_hybrid_connection.test.resource_group_name}"
+  relay_namespace_name = "${azurerm_relay_hybrid_connection.test.relay_namespace_name}"
+}
+`, testAccAzureRMRelayHybridConnection_basic(rInt, location))
+}
+
+func testAccAzureRMRelayHybridConnection_userMetadata(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctestrhc-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  relay_namespace_name = "${azurerm_relay_namespace.test.name}"
+  user_metadata        = "on-premises database"
+}
+`, testAccAzureRMRelayHybridConnection_template(rInt, location), rInt)
+}
@@ -3,11 +3,13 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -45,7 +47,7 @@ func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing resource group: %+v", err)
+				return fmt.Errorf("Error checking for presence of existing resource group: %s", azure.FormatError(err))
 			}
 		}
 
@@ -60,12 +62,12 @@ func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, name, parameters); err != nil {
-		return fmt.Errorf("Error creating resource group: %+v", err)
+		return fmt.Errorf("Error creating Resource Group %q: %s", name, azure.FormatError(err))
 	}
 
 	resp, err := client.Get(ctx, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving resource group: %+v", err)
+		return fmt.Errorf("Error retrieving resource group: %s", azure.FormatError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -92,7 +94,7 @@ func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 
-		return fmt.Errorf("Error reading resource group: %+v", err)
+		return fmt.Errorf("Error reading resource group: %s", azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -121,7 +123,7 @@ func resourceArmResourceGroupExists(d *schema.ResourceData, meta interface{}) (b
 			return false, nil
 		}
 
-		return false, fmt.Errorf("Error reading resource group: %+v", err)
+		return false, fmt.Errorf("Error reading resource group: %s", azure.FormatError(err))
 	}
 
 	return true, nil
@@ -138,13 +140,19 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	name := id.ResourceGroup
 
+	if meta.(*ArmClient).features.ResourceGroup.PreventDeletionIfContainsResources {
+		if err := resourceArmResourceGroupEnsureIsEmpty(meta, name); err != nil {
+			return err
+		}
+	}
+
 	deleteFuture, err := client.Delete(ctx, name)
 	if err != nil {
 		if response.WasNotFound(deleteFuture.Response()) {
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Resource Group %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Resource Group %q: %s", name, azure.FormatError(err))
 	}
 
 	err = deleteFuture.WaitForCompletionRef(ctx, client.Client)
@@ -153,8 +161,46 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Resource Group %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Resource Group %q: %s", name, azure.FormatError(err))
 	}
 
 	return nil
 }
+
+// resourceArmResourceGroupEnsureIsEmpty returns an error listing the resources within the Resource Group (if any),
+// since deleting a Resource Group also deletes everything within it - including resources not managed by Terraform
+func resourceArmResourceGroupEnsureIsEmpty(meta interface{}, name string) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceIds := make([]string, 0)
+	iterator, err := client.ListByResourceGroupComplete(ctx, name, "", "", nil)
+	if err != nil {
+		if utils.ResponseWasNotFound(iterator.Response().Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error listing the resources within Resource Group %q: %s", name, azure.FormatError(err))
+	}
+	for iterator.NotDone() {
+		if v := iterator.Value().ID; v != nil {
+			resourceIds = append(resourceIds, *v)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing the resources within Resource Group %q: %+v", name, err)
+		}
+	}
+
+	if len(resourceIds) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf(`Deleting Resource Group %q was prevented since it still contains %d resource(s):
+
+%s
+
+These resources must be deleted (or moved) before the Resource Group can be deleted - alternatively this check can be
+disabled by setting 'prevent_deletion_if_contains_resources' to false within the 'resource_group' block of the
+Provider's 'features' block.`, name, len(resourceIds), "  - "+strings.Join(resourceIds, "\n  - "))
+}
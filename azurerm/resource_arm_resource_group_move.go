@@ -0,0 +1,186 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+)
+
+func resourceArmResourceGroupMove() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmResourceGroupMoveCreate,
+		Read:   resourceArmResourceGroupMoveRead,
+		Delete: resourceArmResourceGroupMoveDelete,
+
+		Schema: map[string]*schema.Schema{
+			"resource_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"target_resource_group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"source_resource_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"moved_resource_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceArmResourceGroupMoveCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Resource Group Move.")
+
+	targetResourceGroupId := d.Get("target_resource_group_id").(string)
+	resourceIds := make([]string, 0)
+	for _, v := range d.Get("resource_ids").(*schema.Set).List() {
+		resourceIds = append(resourceIds, v.(string))
+	}
+
+	// all of the resources being moved must be in the same Resource Group, which must be within the
+	// Subscription the Provider is configured for - however the target can be in another Subscription
+	sourceResourceGroup := ""
+	for _, resourceId := range resourceIds {
+		if err := azure.ValidateResourceIDInSubscription(resourceId, subscriptionId); err != nil {
+			return err
+		}
+
+		id, err := parseAzureResourceID(resourceId)
+		if err != nil {
+			return err
+		}
+
+		if sourceResourceGroup == "" {
+			sourceResourceGroup = id.ResourceGroup
+			continue
+		}
+
+		if !strings.EqualFold(sourceResourceGroup, id.ResourceGroup) {
+			return fmt.Errorf("All of the `resource_ids` must be in the same Resource Group but found both %q and %q", sourceResourceGroup, id.ResourceGroup)
+		}
+	}
+
+	targetId, err := parseAzureResourceID(targetResourceGroupId)
+	if err != nil {
+		return err
+	}
+
+	if len(targetId.Path) > 0 {
+		return fmt.Errorf("`target_resource_group_id` must be the ID of a Resource Group but got %q", targetResourceGroupId)
+	}
+
+	sourceResourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionId, sourceResourceGroup)
+	if strings.EqualFold(sourceResourceGroupId, targetResourceGroupId) {
+		return fmt.Errorf("The `target_resource_group_id` must be a different Resource Group to the one containing the `resource_ids` (%q)", sourceResourceGroup)
+	}
+
+	parameters := resources.MoveInfo{
+		ResourcesProperty:   &resourceIds,
+		TargetResourceGroup: &targetResourceGroupId,
+	}
+
+	// the preflight validation catches unsupported resource types and missing permissions before anything is moved
+	log.Printf("[DEBUG] Validating the move of %d resources from Resource Group %q to %q", len(resourceIds), sourceResourceGroup, targetResourceGroupId)
+	validateFuture, err := client.ValidateMoveResources(ctx, sourceResourceGroup, parameters)
+	if err != nil {
+		return fmt.Errorf("Error validating the move of resources from Resource Group %q to %q: %s", sourceResourceGroup, targetResourceGroupId, azure.FormatError(err))
+	}
+
+	if err = validateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for validation of the move of resources from Resource Group %q to %q: %s", sourceResourceGroup, targetResourceGroupId, azure.FormatError(err))
+	}
+
+	log.Printf("[DEBUG] Moving %d resources from Resource Group %q to %q", len(resourceIds), sourceResourceGroup, targetResourceGroupId)
+	future, err := client.MoveResources(ctx, sourceResourceGroup, parameters)
+	if err != nil {
+		return fmt.Errorf("Error moving resources from Resource Group %q to %q: %s", sourceResourceGroup, targetResourceGroupId, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the move of resources from Resource Group %q to %q: %s", sourceResourceGroup, targetResourceGroupId, azure.FormatError(err))
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", sourceResourceGroupId, targetResourceGroupId))
+
+	return resourceArmResourceGroupMoveRead(d, meta)
+}
+
+func resourceArmResourceGroupMoveRead(d *schema.ResourceData, meta interface{}) error {
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {sourceResourceGroupId}|{targetResourceGroupId} but got %q", d.Id())
+	}
+
+	sourceId, err := parseAzureResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+
+	targetResourceGroupId := splitId[1]
+
+	movedResourceIds := make([]interface{}, 0)
+	for _, v := range d.Get("resource_ids").(*schema.Set).List() {
+		movedResourceId, err := resourceGroupMoveTargetResourceId(v.(string), targetResourceGroupId)
+		if err != nil {
+			return err
+		}
+
+		movedResourceIds = append(movedResourceIds, movedResourceId)
+	}
+
+	d.Set("source_resource_group_name", sourceId.ResourceGroup)
+	d.Set("target_resource_group_id", targetResourceGroupId)
+	if err := d.Set("moved_resource_ids", schema.NewSet(schema.HashString, movedResourceIds)); err != nil {
+		return fmt.Errorf("Error setting `moved_resource_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmResourceGroupMoveDelete(_ *schema.ResourceData, _ interface{}) error {
+	// moving resources is a one-off operation, so there's nothing to undo here - the resources remain
+	// in the target Resource Group and would need to be moved back explicitly
+	log.Printf("[DEBUG] Removing Resource Group Move from the state - the resources will remain in the target Resource Group")
+	return nil
+}
+
+// resourceGroupMoveTargetResourceId returns the ID the resource will have once it's been moved into the
+// target Resource Group, e.g. `/subscriptions/{sub}/resourceGroups/{target}/providers/{type}/{name}`
+func resourceGroupMoveTargetResourceId(resourceId string, targetResourceGroupId string) (string, error) {
+	// an ID is in the format `/subscriptions/{sub}/resourceGroups/{group}/...` - so everything after the Resource Group is kept
+	segments := strings.Split(strings.TrimPrefix(resourceId, "/"), "/")
+	if len(segments) < 5 {
+		return "", fmt.Errorf("Expected %q to be the ID of a resource within a Resource Group", resourceId)
+	}
+
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(targetResourceGroupId, "/"), strings.Join(segments[4:], "/")), nil
+}
@@ -0,0 +1,145 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestResourceGroupMoveTargetResourceId(t *testing.T) {
+	cases := []struct {
+		ResourceID  string
+		Expected    string
+		ShouldError bool
+	}{
+		{
+			ResourceID:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/source",
+			ShouldError: true,
+		},
+		{
+			ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/source/providers/Microsoft.Network/publicIPAddresses/pip1",
+			Expected:   "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/target/providers/Microsoft.Network/publicIPAddresses/pip1",
+		},
+		{
+			ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/source/providers/Microsoft.Sql/servers/server1/databases/db1",
+			Expected:   "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/target/providers/Microsoft.Sql/servers/server1/databases/db1",
+		},
+	}
+
+	targetResourceGroupId := "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/target"
+	for _, tc := range cases {
+		actual, err := resourceGroupMoveTargetResourceId(tc.ResourceID, targetResourceGroupId)
+		if err != nil {
+			if tc.ShouldError {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.ResourceID, err)
+		}
+
+		if tc.ShouldError {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.ResourceID)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccAzureRMResourceGroupMove_basic(t *testing.T) {
+	resourceName := "azurerm_resource_group_move.test"
+	ri := tf.AccRandTimeInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroupMove_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupMoveCompleted(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_resource_group_name", fmt.Sprintf("acctestRG-source-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "moved_resource_ids.#", "1"),
+				),
+				// the Public IP no longer exists in the source Resource Group, so Terraform will plan to re-create it
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMResourceGroupMoveCompleted(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		targetId, err := parseAzureResourceID(rs.Primary.Attributes["target_resource_group_id"])
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		found := make(map[string]bool)
+		iter, err := client.ListByResourceGroupComplete(ctx, targetId.ResourceGroup, "", "", nil)
+		if err != nil {
+			return fmt.Errorf("Bad: listing resources in Resource Group %q: %+v", targetId.ResourceGroup, err)
+		}
+
+		for iter.NotDone() {
+			if id := iter.Value().ID; id != nil {
+				found[strings.ToLower(*id)] = true
+			}
+
+			if err := iter.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Bad: listing resources in Resource Group %q: %+v", targetId.ResourceGroup, err)
+			}
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "moved_resource_ids.") || k == "moved_resource_ids.#" {
+				continue
+			}
+
+			if !found[strings.ToLower(v)] {
+				return fmt.Errorf("Bad: resource %q was not found in Resource Group %q", v, targetId.ResourceGroup)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMResourceGroupMove_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "source" {
+  name     = "acctestRG-source-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group" "target" {
+  name     = "acctestRG-target-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = "${azurerm_resource_group.source.location}"
+  resource_group_name = "${azurerm_resource_group.source.name}"
+  allocation_method   = "Static"
+}
+
+resource "azurerm_resource_group_move" "test" {
+  resource_ids             = ["${azurerm_public_ip.test.id}"]
+  target_resource_group_id = "${azurerm_resource_group.target.id}"
+}
+`, rInt, location, rInt, location, rInt)
+}
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
@@ -10,6 +11,56 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_resource_group", &resource.Sweeper{
+		Name: "azurerm_resource_group",
+		Dependencies: []string{
+			"azurerm_scheduler_job_collection",
+			"azurerm_virtual_machine",
+		},
+		F: testSweepResourceGroups,
+	})
+}
+
+func testSweepResourceGroups(region string) error {
+	armClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := armClient.resourceGroupsClient
+	ctx := armClient.StopContext
+
+	log.Printf("[DEBUG] Listing Resource Groups to sweep in %q", region)
+	groups, err := client.ListComplete(ctx, "", nil)
+	if err != nil {
+		return fmt.Errorf("Error listing Resource Groups: %+v", err)
+	}
+
+	for groups.NotDone() {
+		group := groups.Value()
+		if group.Name != nil && group.Location != nil && shouldSweepAcceptanceTestResource(*group.Name, *group.Location, region) {
+			name := *group.Name
+			log.Printf("[DEBUG] Deleting Resource Group %q", name)
+
+			future, err := client.Delete(ctx, name)
+			if err != nil {
+				return fmt.Errorf("Error deleting Resource Group %q: %+v", name, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for deletion of Resource Group %q: %+v", name, err)
+			}
+		}
+
+		if err := groups.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Resource Groups: %+v", err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccAzureRMResourceGroup_basic(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
 	ri := tf.AccRandTimeInt()
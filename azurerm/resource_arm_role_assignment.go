@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -82,7 +83,7 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 		roleName := v.(string)
 		roleDefinitions, err := roleDefinitionsClient.List(ctx, "", fmt.Sprintf("roleName eq '%s'", roleName))
 		if err != nil {
-			return fmt.Errorf("Error loading Role Definition List: %+v", err)
+			return fmt.Errorf("Error loading Role Definition List: %s", azure.FormatError(err))
 		}
 		if len(roleDefinitions.Values()) != 1 {
 			return fmt.Errorf("Error loading Role Definition List: could not find role '%s'", roleName)
@@ -136,6 +137,23 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Cannot read Role Assignment ID for %q (Scope %q)", name, scope)
 	}
 
+	// Role Assignments are replicated after they've been created, so using them immediately can fail
+	description := fmt.Sprintf("Role Assignment %q (Scope %q)", name, scope)
+	if err := waitForEventualConsistency(meta.(*ArmClient), description, func() (bool, error) {
+		resp, err := roleAssignmentsClient.Get(ctx, scope, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("Error retrieving Role Assignment %q (Scope %q): %s", name, scope, azure.FormatError(err))
+		}
+
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
 	d.SetId(*read.ID)
 	return resourceArmRoleAssignmentRead(d, meta)
 }
@@ -153,7 +171,7 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 			return nil
 		}
 
-		return fmt.Errorf("Error loading Role Assignment %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error loading Role Assignment %q: %s", d.Id(), azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -167,7 +185,7 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 		if roleId := props.RoleDefinitionID; roleId != nil {
 			roleResp, err := roleDefinitionsClient.GetByID(ctx, *roleId)
 			if err != nil {
-				return fmt.Errorf("Error loading Role Definition %q: %+v", *roleId, err)
+				return fmt.Errorf("Error loading Role Definition %q: %s", *roleId, azure.FormatError(err))
 			}
 
 			if roleProps := roleResp.RoleDefinitionProperties; roleProps != nil {
@@ -224,7 +242,7 @@ func retryRoleAssignmentsClient(scope string, name string, properties authorizat
 				return resource.RetryableError(err)
 			}
 
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(fmt.Errorf("Error creating Role Assignment %q (Scope %q): %s", name, scope, azure.FormatError(err)))
 		}
 
 		return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -169,7 +170,7 @@ func resourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) err
 			return nil
 		}
 
-		return fmt.Errorf("Error loading Role Definition %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error loading Role Definition %q: %s", d.Id(), azure.FormatError(err))
 	}
 
 	if props := resp.RoleDefinitionProperties; props != nil {
@@ -202,7 +203,7 @@ func resourceArmRoleDefinitionDelete(d *schema.ResourceData, meta interface{}) e
 	resp, err := client.Delete(ctx, id.scope, id.roleDefinitionId)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp.Response) {
-			return fmt.Errorf("Error deleting Role Definition %q at Scope %q: %+v", id.roleDefinitionId, id.scope, err)
+			return fmt.Errorf("Error deleting Role Definition %q at Scope %q: %s", id.roleDefinitionId, id.scope, azure.FormatError(err))
 		}
 	}
 
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -277,11 +278,9 @@ func resourceArmSchedulerJobActionWebSchema(propertyName string) *schema.Resourc
 			},
 
 			"method": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"Get", "Put", "Post", "Delete",
-				}, true),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.HTTPMethod(true),
 			},
 
 			//only valid/used when action type is put
@@ -292,8 +291,9 @@ func resourceArmSchedulerJobActionWebSchema(propertyName string) *schema.Resourc
 			},
 
 			"headers": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validate.HTTPHeaders,
 			},
 
 			//authentication requires HTTPS
@@ -482,6 +482,12 @@ func resourceArmSchedulerJobCreateUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
+	if d.IsNewResource() {
+		if err := resourceArmSchedulerJobCheckCollectionQuota(meta, resourceGroup, jobCollection); err != nil {
+			return err
+		}
+	}
+
 	job := scheduler.JobDefinition{
 		Properties: &scheduler.JobProperties{
 			Action: expandAzureArmSchedulerJobAction(d, meta),
@@ -510,7 +516,7 @@ func resourceArmSchedulerJobCreateUpdate(d *schema.ResourceData, meta interface{
 
 	resp, err := client.CreateOrUpdate(ctx, resourceGroup, jobCollection, name, job)
 	if err != nil {
-		return fmt.Errorf("Error creating/updating Scheduler Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating/updating Scheduler Job %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -518,6 +524,54 @@ func resourceArmSchedulerJobCreateUpdate(d *schema.ResourceData, meta interface{
 	return resourceArmSchedulerJobRead(d, meta)
 }
 
+// resourceArmSchedulerJobCheckCollectionQuota returns an error if the Job Collection already contains as many Jobs as its
+// quota allows, since the API error returned when creating another Job doesn't explain this
+func resourceArmSchedulerJobCheckCollectionQuota(meta interface{}, resourceGroup string, jobCollection string) error {
+	client := meta.(*ArmClient).schedulerJobsClient
+	collectionsClient := meta.(*ArmClient).schedulerJobCollectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	collection, err := collectionsClient.Get(ctx, resourceGroup, jobCollection)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Scheduler Job Collection %q (Resource Group %q): %s", jobCollection, resourceGroup, azure.FormatError(err))
+	}
+
+	props := collection.Properties
+	if props == nil {
+		return nil
+	}
+
+	maxJobCount := 0
+	if props.Sku != nil {
+		maxJobCount = schedulerJobCollectionMaxJobCount[strings.ToLower(string(props.Sku.Name))]
+	}
+	if props.Quota != nil && props.Quota.MaxJobCount != nil {
+		maxJobCount = int(*props.Quota.MaxJobCount)
+	}
+	if maxJobCount <= 0 {
+		return nil
+	}
+
+	count := 0
+	iterator, err := client.ListComplete(ctx, resourceGroup, jobCollection, nil, nil, "")
+	if err != nil {
+		return fmt.Errorf("Error listing Jobs in Scheduler Job Collection %q (Resource Group %q): %s", jobCollection, resourceGroup, azure.FormatError(err))
+	}
+	for iterator.NotDone() {
+		count++
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Jobs in Scheduler Job Collection %q (Resource Group %q): %+v", jobCollection, resourceGroup, err)
+		}
+	}
+
+	if count >= maxJobCount {
+		return fmt.Errorf("Scheduler Job Collection %q (Resource Group %q) already contains %d Jobs, which is the maximum allowed by its quota - either increase `max_job_count` or use a higher SKU", jobCollection, resourceGroup, count)
+	}
+
+	return nil
+}
+
 func resourceArmSchedulerJobRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).schedulerJobsClient
 	ctx := meta.(*ArmClient).StopContext
@@ -540,7 +594,7 @@ func resourceArmSchedulerJobRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Scheduler Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Scheduler Job %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	//standard properties
@@ -630,7 +684,7 @@ func resourceArmSchedulerJobDelete(d *schema.ResourceData, meta interface{}) err
 	resp, err := client.Delete(ctx, resourceGroup, jobCollection, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error issuing delete request for Scheduler Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error issuing delete request for Scheduler Job %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -24,6 +26,8 @@ func resourceArmSchedulerJobCollection() *schema.Resource {
 		Update: resourceArmSchedulerJobCollectionCreateUpdate,
 		Delete: resourceArmSchedulerJobCollectionDelete,
 
+		CustomizeDiff: resourceArmSchedulerJobCollectionCustomizeDiff,
+
 		DeprecationMessage: "Scheduler Job Collection has been deprecated in favour of Logic Apps - more information can be found at https://docs.microsoft.com/en-us/azure/scheduler/migrate-from-scheduler-to-logic-apps",
 
 		Importer: &schema.ResourceImporter{
@@ -72,6 +76,17 @@ func resourceArmSchedulerJobCollection() *schema.Resource {
 				}, true),
 			},
 
+			// the state of the jobs can only be set (not read) at the collection level
+			"jobs_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(scheduler.JobStateEnabled),
+					string(scheduler.JobStateDisabled),
+				}, true),
+			},
+
 			"quota": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -84,7 +99,7 @@ func resourceArmSchedulerJobCollection() *schema.Resource {
 						"max_job_count": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							ValidateFunc: validation.IntAtLeast(0),
+							ValidateFunc: validation.IntBetween(0, 1000),
 						},
 
 						"max_recurrence_frequency": {
@@ -122,6 +137,40 @@ func resourceArmSchedulerJobCollection() *schema.Resource {
 	}
 }
 
+// schedulerJobCollectionMaxJobCount is the maximum number of Jobs which a Job Collection of each SKU can contain
+var schedulerJobCollectionMaxJobCount = map[string]int{
+	strings.ToLower(string(scheduler.Free)):       5,
+	strings.ToLower(string(scheduler.Standard)):   50,
+	strings.ToLower(string(scheduler.P10Premium)): 50,
+	strings.ToLower(string(scheduler.P20Premium)): 1000,
+}
+
+func resourceArmSchedulerJobCollectionCustomizeDiff(diff *schema.ResourceDiff, _ interface{}) error {
+	sku := diff.Get("sku").(string)
+
+	quotas := diff.Get("quota").([]interface{})
+	if len(quotas) == 0 || quotas[0] == nil {
+		return nil
+	}
+
+	quota := quotas[0].(map[string]interface{})
+	return validateSchedulerJobCollectionQuota(sku, quota["max_job_count"].(int), quota["max_recurrence_frequency"].(string))
+}
+
+// validateSchedulerJobCollectionQuota ensures the quota is within the limits of the SKU, since otherwise the
+// API accepts it - but Jobs then fail to be created (or run) with an error which doesn't mention the quota
+func validateSchedulerJobCollectionQuota(sku string, maxJobCount int, maxRecurrenceFrequency string) error {
+	if limit, ok := schedulerJobCollectionMaxJobCount[strings.ToLower(sku)]; ok && maxJobCount > limit {
+		return fmt.Errorf("`max_job_count` must be at most %d for a Job Collection with the %q SKU (got %d)", limit, sku, maxJobCount)
+	}
+
+	if strings.EqualFold(sku, string(scheduler.Free)) && strings.EqualFold(maxRecurrenceFrequency, string(scheduler.Minute)) {
+		return fmt.Errorf("Jobs in a Job Collection with the %q SKU can recur at most hourly - `max_recurrence_frequency` cannot be %q", sku, maxRecurrenceFrequency)
+	}
+
+	return nil
+}
+
 func resourceArmSchedulerJobCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).schedulerJobCollectionsClient
 	ctx := meta.(*ArmClient).StopContext
@@ -137,7 +186,7 @@ func resourceArmSchedulerJobCollectionCreateUpdate(d *schema.ResourceData, meta
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Scheduler Job Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Scheduler Job Collection %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 			}
 		}
 
@@ -164,13 +213,19 @@ func resourceArmSchedulerJobCollectionCreateUpdate(d *schema.ResourceData, meta
 	//create job collection
 	collection, err := client.CreateOrUpdate(ctx, resourceGroup, name, collection)
 	if err != nil {
-		return fmt.Errorf("Error creating/updating Scheduler Job Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating/updating Scheduler Job Collection %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	if d.HasChange("jobs_state") {
+		if err := resourceArmSchedulerJobCollectionSetJobsState(d, meta, resourceGroup, name); err != nil {
+			return err
+		}
 	}
 
 	//ensure collection actually exists and we have the correct ID
 	collection, err = client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error reading Scheduler Job Collection %q after create/update (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error reading Scheduler Job Collection %q after create/update (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	d.SetId(*collection.ID)
@@ -199,7 +254,7 @@ func resourceArmSchedulerJobCollectionRead(d *schema.ResourceData, meta interfac
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Scheduler Job Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Scheduler Job Collection %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	//standard properties
@@ -242,19 +297,55 @@ func resourceArmSchedulerJobCollectionDelete(d *schema.ResourceData, meta interf
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("Error issuing delete request for Scheduler Job Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error issuing delete request for Scheduler Job Collection %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("Error waiting for deletion of Scheduler Job Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error waiting for deletion of Scheduler Job Collection %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
 	return nil
 }
 
+func resourceArmSchedulerJobCollectionSetJobsState(d *schema.ResourceData, meta interface{}, resourceGroup string, name string) error {
+	client := meta.(*ArmClient).schedulerJobCollectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	jobsState := d.Get("jobs_state").(string)
+	if jobsState == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Setting the state of all Jobs in Scheduler Job Collection %q (resource group %q) to %q", name, resourceGroup, jobsState)
+
+	if strings.EqualFold(jobsState, string(scheduler.JobStateDisabled)) {
+		future, err := client.Disable(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error disabling Jobs in Scheduler Job Collection %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Jobs in Scheduler Job Collection %q (Resource Group %q) to be disabled: %s", name, resourceGroup, azure.FormatError(err))
+		}
+
+		return nil
+	}
+
+	future, err := client.Enable(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error enabling Jobs in Scheduler Job Collection %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Jobs in Scheduler Job Collection %q (Resource Group %q) to be enabled: %s", name, resourceGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
 func expandAzureArmSchedulerJobCollectionQuota(d *schema.ResourceData) *scheduler.JobCollectionQuota {
 	if qb, ok := d.Get("quota").([]interface{}); ok && len(qb) > 0 {
 		quota := scheduler.JobCollectionQuota{
@@ -4,6 +4,7 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
@@ -14,6 +15,116 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_scheduler_job_collection", &resource.Sweeper{
+		Name: "azurerm_scheduler_job_collection",
+		F:    testSweepSchedulerJobCollections,
+	})
+}
+
+// deleting a Job Collection also deletes the Jobs within it, so there's no separate Sweeper for Jobs
+func testSweepSchedulerJobCollections(region string) error {
+	armClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := armClient.schedulerJobCollectionsClient
+	ctx := armClient.StopContext
+
+	log.Printf("[DEBUG] Listing Scheduler Job Collections to sweep in %q", region)
+	collections, err := client.ListBySubscriptionComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error listing Scheduler Job Collections: %+v", err)
+	}
+
+	for collections.NotDone() {
+		collection := collections.Value()
+		if collection.ID != nil && collection.Name != nil && collection.Location != nil && shouldSweepAcceptanceTestResource(*collection.Name, *collection.Location, region) {
+			id, err := parseAzureResourceID(*collection.ID)
+			if err != nil {
+				return err
+			}
+
+			name := *collection.Name
+			log.Printf("[DEBUG] Deleting Scheduler Job Collection %q (Resource Group %q)", name, id.ResourceGroup)
+
+			future, err := client.Delete(ctx, id.ResourceGroup, name)
+			if err != nil {
+				return fmt.Errorf("Error deleting Scheduler Job Collection %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for deletion of Scheduler Job Collection %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+			}
+		}
+
+		if err := collections.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Scheduler Job Collections: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func TestValidateSchedulerJobCollectionQuota(t *testing.T) {
+	testData := []struct {
+		Sku         string
+		MaxJobCount int
+		Frequency   string
+		ShouldError bool
+	}{
+		{
+			Sku:         "Free",
+			MaxJobCount: 5,
+			Frequency:   "Hour",
+			ShouldError: false,
+		},
+		{
+			Sku:         "free",
+			MaxJobCount: 6,
+			Frequency:   "Hour",
+			ShouldError: true,
+		},
+		{
+			Sku:         "Free",
+			MaxJobCount: 1,
+			Frequency:   "minute",
+			ShouldError: true,
+		},
+		{
+			Sku:         "Standard",
+			MaxJobCount: 50,
+			Frequency:   "Minute",
+			ShouldError: false,
+		},
+		{
+			Sku:         "P10Premium",
+			MaxJobCount: 51,
+			Frequency:   "Minute",
+			ShouldError: true,
+		},
+		{
+			Sku:         "P20Premium",
+			MaxJobCount: 1000,
+			Frequency:   "Minute",
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q with %d Jobs recurring every %q..", v.Sku, v.MaxJobCount, v.Frequency)
+
+		err := validateSchedulerJobCollectionQuota(v.Sku, v.MaxJobCount, v.Frequency)
+		if v.ShouldError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ShouldError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
 func TestAccAzureRMSchedulerJobCollection_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	resourceName := "azurerm_scheduler_job_collection.test"
@@ -88,6 +199,38 @@ func TestAccAzureRMSchedulerJobCollection_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSchedulerJobCollection_jobsState(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	jobResourceName := "azurerm_scheduler_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSchedulerJobCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSchedulerJobCollection_jobsState(ri, location, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSchedulerJobState(jobResourceName, scheduler.JobStateEnabled),
+				),
+			},
+			{
+				Config: testAccAzureRMSchedulerJobCollection_jobsState(ri, location, "Disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSchedulerJobState(jobResourceName, scheduler.JobStateDisabled),
+				),
+			},
+			{
+				Config: testAccAzureRMSchedulerJobCollection_jobsState(ri, location, "Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSchedulerJobState(jobResourceName, scheduler.JobStateEnabled),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMSchedulerJobCollectionDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_scheduler_job_collection" {
@@ -212,3 +355,56 @@ func checkAccAzureRMSchedulerJobCollection_complete(resourceName string) resourc
 		resource.TestCheckResourceAttr(resourceName, "quota.0.max_recurrence_frequency", "hour"),
 	)
 }
+
+func testCheckAzureRMSchedulerJobState(resourceName string, state scheduler.JobState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		jobCollection := rs.Primary.Attributes["job_collection_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).schedulerJobsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, jobCollection, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on schedulerJobsClient: %+v", err)
+		}
+
+		if resp.Properties == nil {
+			return fmt.Errorf("Bad: `properties` was nil for Scheduler Job %q (resource group: %q)", name, resourceGroup)
+		}
+
+		if resp.Properties.State != state {
+			return fmt.Errorf("Bad: expected Scheduler Job %q (resource group: %q) to be %q but got %q", name, resourceGroup, state, resp.Properties.State)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSchedulerJobCollection_jobsState(rInt int, location string, jobsState string) string {
+	additional := ""
+	if jobsState != "" {
+		additional = fmt.Sprintf("jobs_state = %q", jobsState)
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_scheduler_job" "test" {
+  name                = "acctest-%d-job"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  job_collection_name = "${azurerm_scheduler_job_collection.test.name}"
+
+  action_web {
+    url    = "http://example.com"
+    method = "get"
+  }
+}
+`, testAccAzureRMSchedulerJobCollection_basic(rInt, location, additional), rInt)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/2017-08-01-preview/security"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -65,7 +66,7 @@ func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta i
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Security Center Contact: %+v", err)
+				return fmt.Errorf("Error checking for presence of existing Security Center Contact: %s", azure.FormatError(err))
 			}
 		}
 
@@ -96,12 +97,12 @@ func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta i
 	if d.IsNewResource() {
 
 		if _, err := client.Create(ctx, name, contact); err != nil {
-			return fmt.Errorf("Error creating Security Center Contact: %+v", err)
+			return fmt.Errorf("Error creating Security Center Contact: %s", azure.FormatError(err))
 		}
 
 		resp, err := client.Get(ctx, name)
 		if err != nil {
-			return fmt.Errorf("Error reading Security Center Contact: %+v", err)
+			return fmt.Errorf("Error reading Security Center Contact: %s", azure.FormatError(err))
 		}
 		if resp.ID == nil {
 			return fmt.Errorf("Security Center Contact ID is nil")
@@ -110,7 +111,7 @@ func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta i
 		d.SetId(*resp.ID)
 	} else {
 		if _, err := client.Update(ctx, name, contact); err != nil {
-			return fmt.Errorf("Error updating Security Center Contact: %+v", err)
+			return fmt.Errorf("Error updating Security Center Contact: %s", azure.FormatError(err))
 		}
 	}
 
@@ -131,7 +132,7 @@ func resourceArmSecurityCenterContactRead(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Security Center Contact: %+v", err)
+		return fmt.Errorf("Error reading Security Center Contact: %s", azure.FormatError(err))
 	}
 
 	if properties := resp.ContactProperties; properties != nil {
@@ -157,7 +158,7 @@ func resourceArmSecurityCenterContactDelete(_ *schema.ResourceData, meta interfa
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Security Center Contact: %+v", err)
+		return fmt.Errorf("Error deleting Security Center Contact: %s", azure.FormatError(err))
 	}
 
 	return nil
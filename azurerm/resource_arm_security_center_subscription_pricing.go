@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/2017-08-01-preview/security"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -54,12 +55,12 @@ func resourceArmSecurityCenterSubscriptionPricingUpdate(d *schema.ResourceData,
 	}
 
 	if _, err := client.UpdateSubscriptionPricing(ctx, name, pricing); err != nil {
-		return fmt.Errorf("Error creating/updating Security Center Subscription pricing: %+v", err)
+		return fmt.Errorf("Error creating/updating Security Center Subscription pricing: %s", azure.FormatError(err))
 	}
 
 	resp, err := client.GetSubscriptionPricing(ctx, name)
 	if err != nil {
-		return fmt.Errorf("Error reading Security Center Subscription pricing: %+v", err)
+		return fmt.Errorf("Error reading Security Center Subscription pricing: %s", azure.FormatError(err))
 	}
 	if resp.ID == nil {
 		return fmt.Errorf("Security Center Subscription pricing ID is nil")
@@ -82,7 +83,7 @@ func resourceArmSecurityCenterSubscriptionPricingRead(d *schema.ResourceData, me
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Security Center Subscription pricing: %+v", err)
+		return fmt.Errorf("Error reading Security Center Subscription pricing: %s", azure.FormatError(err))
 	}
 
 	if properties := resp.PricingProperties; properties != nil {
@@ -11,11 +11,12 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-//only valid name is default
+// only valid name is default
 // Message="Invalid workspace settings name 'kttest' , only default is allowed "
 const securityCenterWorkspaceName = "default"
 
@@ -38,9 +39,10 @@ func resourceArmSecurityCenterWorkspace() *schema.Resource {
 			},
 
 			"workspace_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 		},
 	}
@@ -57,7 +59,7 @@ func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Security Center Workspace: %+v", err)
+				return fmt.Errorf("Error checking for presence of existing Security Center Workspace: %s", azure.FormatError(err))
 			}
 		}
 
@@ -70,7 +72,7 @@ func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta
 	//API does not error, it just doesn't set the workspace scope
 	price, err := priceClient.GetSubscriptionPricing(ctx, securityCenterSubscriptionPricingName)
 	if err != nil {
-		return fmt.Errorf("Error reading Security Center Subscription pricing: %+v", err)
+		return fmt.Errorf("Error reading Security Center Subscription pricing: %s", azure.FormatError(err))
 	}
 
 	if price.PricingProperties == nil {
@@ -89,11 +91,11 @@ func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta
 
 	if d.IsNewResource() {
 		if _, err = client.Create(ctx, name, contact); err != nil {
-			return fmt.Errorf("Error creating Security Center Workspace: %+v", err)
+			return fmt.Errorf("Error creating Security Center Workspace: %s", azure.FormatError(err))
 		}
 	} else {
 		if _, err = client.Update(ctx, name, contact); err != nil {
-			return fmt.Errorf("Error updating Security Center Workspace: %+v", err)
+			return fmt.Errorf("Error updating Security Center Workspace: %s", azure.FormatError(err))
 		}
 	}
 
@@ -144,7 +146,7 @@ func resourceArmSecurityCenterWorkspaceRead(d *schema.ResourceData, meta interfa
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Security Center Workspace: %+v", err)
+		return fmt.Errorf("Error reading Security Center Workspace: %s", azure.FormatError(err))
 	}
 
 	if properties := resp.WorkspaceSettingProperties; properties != nil {
@@ -166,7 +168,7 @@ func resourceArmSecurityCenterWorkspaceDelete(_ *schema.ResourceData, meta inter
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Security Center Workspace: %+v", err)
+		return fmt.Errorf("Error deleting Security Center Workspace: %s", azure.FormatError(err))
 	}
 
 	return nil
@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -92,6 +93,11 @@ func resourceArmServiceBusNamespace() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"fully_qualified_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -181,7 +187,7 @@ func resourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace %q: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -195,6 +201,16 @@ func resourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}
 		d.Set("capacity", sku.Capacity)
 	}
 
+	fullyQualifiedNamespace := ""
+	if props := resp.SBNamespaceProperties; props != nil && props.ServiceBusEndpoint != nil {
+		fqn, err := azure.ServiceBusFullyQualifiedNamespace(*props.ServiceBusEndpoint)
+		if err != nil {
+			return err
+		}
+		fullyQualifiedNamespace = fqn
+	}
+	d.Set("fully_qualified_namespace", fullyQualifiedNamespace)
+
 	keys, err := client.ListKeys(ctx, resourceGroup, name, serviceBusNamespaceDefaultAuthorizationRule)
 	if err != nil {
 		log.Printf("[WARN] Unable to List default keys for Namespace %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -231,7 +247,7 @@ func resourceArmServiceBusNamespaceDelete(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Service Bus %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Service Bus %q: %s", name, azure.FormatError(err))
 	}
 
 	return nil
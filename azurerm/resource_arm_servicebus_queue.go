@@ -152,7 +152,7 @@ func resourceArmServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interfa
 		existing, err := client.Get(ctx, resourceGroup, namespaceName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing ServiceBus Namespace %q (Resource Group %q): %+v", resourceGroup, namespaceName, err)
+				return fmt.Errorf("Error checking for presence of existing ServiceBus Namespace %q (Resource Group %q): %s", resourceGroup, namespaceName, azure.FormatError(err))
 			}
 		}
 
@@ -195,7 +195,7 @@ func resourceArmServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interfa
 	namespacesClient := meta.(*ArmClient).serviceBusNamespacesClient
 	namespace, err := namespacesClient.Get(ctx, resourceGroup, namespaceName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving ServiceBus Namespace %q (Resource Group %q): %+v", resourceGroup, namespaceName, err)
+		return fmt.Errorf("Error retrieving ServiceBus Namespace %q (Resource Group %q): %s", resourceGroup, namespaceName, azure.FormatError(err))
 	}
 
 	// Enforce Premium namespace to have Express Entities disabled in Terraform since they are not supported for
@@ -239,7 +239,7 @@ func resourceArmServiceBusQueueRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Azure ServiceBus Queue %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Queue %q: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
 	"github.com/hashicorp/terraform/helper/schema"
 	uuid "github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -64,7 +65,7 @@ func resourceArmSqlActiveDirectoryAdministratorCreateUpdate(d *schema.ResourceDa
 		existing, err := client.Get(ctx, resGroup, serverName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing SQL Administrator (Resource Group %q, Server %q): %+v", resGroup, serverName, err)
+				return fmt.Errorf("Error checking for presence of existing SQL Administrator (Resource Group %q, Server %q): %s", resGroup, serverName, azure.FormatError(err))
 			}
 		}
 
@@ -84,16 +85,16 @@ func resourceArmSqlActiveDirectoryAdministratorCreateUpdate(d *schema.ResourceDa
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, serverName, parameters)
 	if err != nil {
-		return fmt.Errorf("Error issuing create/update request for SQL Administrator (Resource Group %q, Server %q): %+v", resGroup, serverName, err)
+		return fmt.Errorf("Error issuing create/update request for SQL Administrator (Resource Group %q, Server %q): %s", resGroup, serverName, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting on create/update future for SQL Administrator (Resource Group %q, Server %q): %+v", resGroup, serverName, err)
+		return fmt.Errorf("Error waiting on create/update future for SQL Administrator (Resource Group %q, Server %q): %s", resGroup, serverName, azure.FormatError(err))
 	}
 
 	resp, err := client.Get(ctx, resGroup, serverName)
 	if err != nil {
-		return fmt.Errorf("Error issuing get request for SQL Administrator (Resource Group %q, Server %q): %+v", resGroup, serverName, err)
+		return fmt.Errorf("Error issuing get request for SQL Administrator (Resource Group %q, Server %q): %s", resGroup, serverName, azure.FormatError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -121,7 +122,7 @@ func resourceArmSqlActiveDirectoryAdministratorRead(d *schema.ResourceData, meta
 			return nil
 		}
 
-		return fmt.Errorf("Error reading SQL AD administrator: %+v", err)
+		return fmt.Errorf("Error reading SQL AD administrator: %s", azure.FormatError(err))
 	}
 
 	d.Set("resource_group_name", resourceGroup)
@@ -147,7 +148,7 @@ func resourceArmSqlActiveDirectoryAdministratorDelete(d *schema.ResourceData, me
 
 	_, err = client.Delete(ctx, resourceGroup, serverName)
 	if err != nil {
-		return fmt.Errorf("Error deleting SQL AD administrator: %+v", err)
+		return fmt.Errorf("Error deleting SQL AD administrator: %s", azure.FormatError(err))
 	}
 
 	return nil
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -122,9 +123,10 @@ func resourceArmSqlDatabase() *schema.Resource {
 			},
 
 			"source_database_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"restore_point_in_time": {
@@ -190,6 +192,12 @@ func resourceArmSqlDatabase() *schema.Resource {
 				Computed: true,
 			},
 
+			"zone_redundant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"encryption": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -291,6 +299,22 @@ func resourceArmSqlDatabase() *schema.Resource {
 				},
 			},
 
+			"short_term_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"retention_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 35),
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 
@@ -330,7 +354,7 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		existing, err := client.Get(ctx, resourceGroup, serverName, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+				return fmt.Errorf("Error checking for presence of existing SQL Database %q (Resource Group %q, Server %q): %s", name, resourceGroup, serverName, azure.FormatError(err))
 			}
 		}
 
@@ -347,7 +371,8 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 	properties := sql.Database{
 		Location: utils.String(location),
 		DatabaseProperties: &sql.DatabaseProperties{
-			CreateMode: sql.CreateMode(createMode),
+			CreateMode:    sql.CreateMode(createMode),
+			ZoneRedundant: utils.Bool(d.Get("zone_redundant").(bool)),
 		},
 		Tags: expandTags(tags),
 	}
@@ -422,11 +447,11 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error issuing create/update request for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		return fmt.Errorf("Error issuing create/update request for SQL Database %q (Resource Group %q, Server %q): %s", name, resourceGroup, serverName, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting on create/update future for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		return fmt.Errorf("Error waiting on create/update future for SQL Database %q (Resource Group %q, Server %q): %s", name, resourceGroup, serverName, azure.FormatError(err))
 	}
 
 	if _, ok := d.GetOk("import"); ok {
@@ -451,14 +476,27 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name, "")
 	if err != nil {
-		return fmt.Errorf("Error issuing get request for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		return fmt.Errorf("Error issuing get request for SQL Database %q (Resource Group %q, Server %q): %s", name, resourceGroup, serverName, azure.FormatError(err))
 	}
 
 	d.SetId(*resp.ID)
 
 	threatDetectionClient := meta.(*ArmClient).sqlDatabaseThreatDetectionPoliciesClient
 	if _, err = threatDetectionClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, *threatDetection); err != nil {
-		return fmt.Errorf("Error setting database threat detection policy: %+v", err)
+		return fmt.Errorf("Error setting database threat detection policy: %s", azure.FormatError(err))
+	}
+
+	if v, ok := d.GetOk("short_term_retention_policy"); ok {
+		retentionClient := meta.(*ArmClient).msSqlBackupShortTermRetentionPoliciesClient
+		retentionPolicy := expandArmSqlDatabaseShortTermRetentionPolicy(v.([]interface{}))
+		retentionFuture, err := retentionClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, retentionPolicy)
+		if err != nil {
+			return fmt.Errorf("Error setting Short Term Retention Policy for SQL Database %q (Resource Group %q, Server %q): %s", name, resourceGroup, serverName, azure.FormatError(err))
+		}
+
+		if err = retentionFuture.WaitForCompletionRef(ctx, retentionClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for Short Term Retention Policy for SQL Database %q (Resource Group %q, Server %q): %s", name, resourceGroup, serverName, azure.FormatError(err))
+		}
 	}
 
 	return resourceArmSqlDatabaseRead(d, meta)
@@ -485,7 +523,7 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Sql Database %s: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Sql Database %s: %s", name, azure.FormatError(err))
 	}
 
 	threatDetectionClient := meta.(*ArmClient).sqlDatabaseThreatDetectionPoliciesClient
@@ -497,6 +535,15 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	// Data Warehouses don't support Short Term Retention Policies, so errors here are ignored
+	retentionClient := meta.(*ArmClient).msSqlBackupShortTermRetentionPoliciesClient
+	retentionPolicy, err := retentionClient.Get(ctx, resourceGroup, serverName, name)
+	if err == nil {
+		if err := d.Set("short_term_retention_policy", flattenArmSqlDatabaseShortTermRetentionPolicy(retentionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `short_term_retention_policy`: %+v", err)
+		}
+	}
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resourceGroup)
 	if location := resp.Location; location != nil {
@@ -515,6 +562,7 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("elastic_pool_name", props.ElasticPoolName)
 		d.Set("max_size_bytes", props.MaxSizeBytes)
 		d.Set("requested_service_objective_name", string(props.RequestedServiceObjectiveName))
+		d.Set("zone_redundant", props.ZoneRedundant)
 
 		if cd := props.CreationDate; cd != nil {
 			d.Set("creation_date", cd.String())
@@ -559,7 +607,7 @@ func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting SQL Database: %+v", err)
+		return fmt.Errorf("Error deleting SQL Database: %s", azure.FormatError(err))
 	}
 
 	return nil
@@ -698,3 +746,26 @@ func expandArmSqlServerThreatDetectionPolicy(d *schema.ResourceData, location st
 
 	return &policy, nil
 }
+
+func expandArmSqlDatabaseShortTermRetentionPolicy(input []interface{}) MsSql.BackupShortTermRetentionPolicy {
+	policy := input[0].(map[string]interface{})
+
+	return MsSql.BackupShortTermRetentionPolicy{
+		BackupShortTermRetentionPolicyProperties: &MsSql.BackupShortTermRetentionPolicyProperties{
+			RetentionDays: utils.Int32(int32(policy["retention_days"].(int))),
+		},
+	}
+}
+
+func flattenArmSqlDatabaseShortTermRetentionPolicy(input MsSql.BackupShortTermRetentionPolicy) []interface{} {
+	retentionDays := 0
+	if props := input.BackupShortTermRetentionPolicyProperties; props != nil && props.RetentionDays != nil {
+		retentionDays = int(*props.RetentionDays)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"retention_days": retentionDays,
+		},
+	}
+}
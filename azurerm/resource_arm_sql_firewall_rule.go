@@ -68,7 +68,7 @@ func resourceArmSqlFirewallRuleCreateUpdate(d *schema.ResourceData, meta interfa
 		existing, err := client.Get(ctx, resourceGroup, serverName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing SQL Firewall Rule %s (Resource Group %s, Server %s): %+v", name, resourceGroup, serverName, err)
+				return fmt.Errorf("Error checking for presence of existing SQL Firewall Rule %s (Resource Group %s, Server %s): %s", name, resourceGroup, serverName, azure.FormatError(err))
 			}
 		}
 
@@ -85,12 +85,12 @@ func resourceArmSqlFirewallRuleCreateUpdate(d *schema.ResourceData, meta interfa
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, parameters); err != nil {
-		return fmt.Errorf("Error creating SQL Firewall Rule %s (Resource Group %s, Server %s): %+v", name, resourceGroup, serverName, err)
+		return fmt.Errorf("Error creating SQL Firewall Rule %s (Resource Group %s, Server %s): %s", name, resourceGroup, serverName, azure.FormatError(err))
 	}
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving SQL Firewall Rule %s (Resource Group %s, Server %s): %+v", name, resourceGroup, serverName, err)
+		return fmt.Errorf("Error retrieving SQL Firewall Rule %s (Resource Group %s, Server %s): %s", name, resourceGroup, serverName, azure.FormatError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -119,7 +119,7 @@ func resourceArmSqlFirewallRuleRead(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("Error reading SQL Firewall Rule %s (Resource Group %s, Server %s): %+v", name, resourceGroup, serverName, err)
+		return fmt.Errorf("Error reading SQL Firewall Rule %s (Resource Group %s, Server %s): %s", name, resourceGroup, serverName, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -150,7 +150,7 @@ func resourceArmSqlFirewallRuleDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting SQL Firewall Rule: %+v", err)
+		return fmt.Errorf("Error deleting SQL Firewall Rule: %s", azure.FormatError(err))
 	}
 
 	return nil
@@ -24,6 +24,8 @@ func resourceArmSqlServer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmSqlServerCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -53,9 +55,43 @@ func resourceArmSqlServer() *schema.Resource {
 			},
 
 			"administrator_login_password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"administrator_login_password_key_vault_secret_id"},
+			},
+
+			"administrator_login_password_key_vault_secret_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateKeyVaultChildId,
+				ConflictsWith: []string{"administrator_login_password"},
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.SystemAssigned),
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 
 			"fully_qualified_domain_name": {
@@ -85,7 +121,7 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing SQL Server %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -103,14 +139,28 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 		},
 	}
 
-	if d.HasChange("administrator_login_password") {
+	if _, ok := d.GetOk("identity"); ok {
+		parameters.Identity = expandAzureRmSqlServerIdentity(d)
+	}
+
+	if d.HasChange("administrator_login_password") || d.HasChange("administrator_login_password_key_vault_secret_id") {
 		adminPassword := d.Get("administrator_login_password").(string)
+
+		// when a Key Vault Secret is referenced the password is retrieved at apply time, so that it's not in the configuration
+		if secretId := d.Get("administrator_login_password_key_vault_secret_id").(string); secretId != "" {
+			value, err := azure.GetKeyVaultSecretValue(ctx, meta.(*ArmClient).keyVaultManagementClient, secretId)
+			if err != nil {
+				return fmt.Errorf("Error retrieving the Administrator Login Password for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+			adminPassword = value
+		}
+
 		parameters.ServerProperties.AdministratorLoginPassword = utils.String(adminPassword)
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
-		return fmt.Errorf("Error issuing create/update request for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error issuing create/update request for SQL Server %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
@@ -119,12 +169,12 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("SQL Server names need to be globally unique and %q is already in use.", name)
 		}
 
-		return fmt.Errorf("Error waiting on create/update future for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error waiting on create/update future for SQL Server %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error issuing get request for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error issuing get request for SQL Server %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -132,6 +182,18 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 	return resourceArmSqlServerRead(d, meta)
 }
 
+func resourceArmSqlServerCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	// values which aren't known until apply (e.g. a generated password) count as set
+	passwordSet := d.Get("administrator_login_password").(string) != "" || !d.NewValueKnown("administrator_login_password")
+	secretIdSet := d.Get("administrator_login_password_key_vault_secret_id").(string) != "" || !d.NewValueKnown("administrator_login_password_key_vault_secret_id")
+
+	if passwordSet == secretIdSet {
+		return fmt.Errorf("exactly one of `administrator_login_password` or `administrator_login_password_key_vault_secret_id` must be specified")
+	}
+
+	return nil
+}
+
 func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlServersClient
 	ctx := meta.(*ArmClient).StopContext
@@ -152,7 +214,7 @@ func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 
-		return fmt.Errorf("Error reading SQL Server %s: %v", name, err)
+		return fmt.Errorf("Error reading SQL Server %s: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", name)
@@ -161,6 +223,10 @@ func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 
+	if err := d.Set("identity", flattenAzureRmSqlServerIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
 	if serverProperties := resp.ServerProperties; serverProperties != nil {
 		d.Set("version", serverProperties.Version)
 		d.Set("administrator_login", serverProperties.AdministratorLogin)
@@ -186,8 +252,34 @@ func resourceArmSqlServerDelete(d *schema.ResourceData, meta interface{}) error
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting SQL Server %s: %+v", name, err)
+		return fmt.Errorf("Error deleting SQL Server %s: %s", name, azure.FormatError(err))
 	}
 
 	return future.WaitForCompletionRef(ctx, client.Client)
 }
+
+func expandAzureRmSqlServerIdentity(d *schema.ResourceData) *sql.ResourceIdentity {
+	identities := d.Get("identity").([]interface{})
+	identity := identities[0].(map[string]interface{})
+	identityType := sql.IdentityType(identity["type"].(string))
+	return &sql.ResourceIdentity{
+		Type: identityType,
+	}
+}
+
+func flattenAzureRmSqlServerIdentity(identity *sql.ResourceIdentity) []interface{} {
+	if identity == nil {
+		return make([]interface{}, 0)
+	}
+
+	result := make(map[string]interface{})
+	result["type"] = string(identity.Type)
+	if identity.PrincipalID != nil {
+		result["principal_id"] = identity.PrincipalID.String()
+	}
+	if identity.TenantID != nil {
+		result["tenant_id"] = identity.TenantID.String()
+	}
+
+	return []interface{}{result}
+}
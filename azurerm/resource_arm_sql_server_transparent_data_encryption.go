@@ -0,0 +1,204 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlServerTransparentDataEncryption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlServerTransparentDataEncryptionCreateUpdate,
+		Read:   resourceArmSqlServerTransparentDataEncryptionRead,
+		Update: resourceArmSqlServerTransparentDataEncryptionCreateUpdate,
+		Delete: resourceArmSqlServerTransparentDataEncryptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildId,
+			},
+		},
+	}
+}
+
+func resourceArmSqlServerTransparentDataEncryptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	keysClient := meta.(*ArmClient).sqlServerKeysClient
+	ctx := meta.(*ArmClient).StopContext
+
+	serverName := d.Get("server_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	keyVaultKeyId := d.Get("key_vault_key_id").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		// every SQL Server has an Encryption Protector, so only one which isn't Service Managed is treated as existing
+		existing, err := client.Get(ctx, resGroup, serverName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing SQL Server Transparent Data Encryption (Resource Group %q, Server %q): %s", resGroup, serverName, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" && existing.EncryptionProtectorProperties != nil && existing.EncryptionProtectorProperties.ServerKeyType == sql.AzureKeyVault {
+			return tf.ImportAsExistsError("azurerm_sql_server_transparent_data_encryption", *existing.ID)
+		}
+	}
+
+	serverKeyName := "ServiceManaged"
+	serverKeyType := sql.ServiceManaged
+
+	if keyVaultKeyId != "" {
+		keyName, err := sqlServerKeyNameFromKeyVaultKeyId(keyVaultKeyId)
+		if err != nil {
+			return err
+		}
+
+		// the Key Vault Key must be registered as a Server Key before it can be used as the Encryption Protector
+		key := sql.ServerKey{
+			ServerKeyProperties: &sql.ServerKeyProperties{
+				ServerKeyType: sql.AzureKeyVault,
+				URI:           utils.String(keyVaultKeyId),
+			},
+		}
+
+		keyFuture, err := keysClient.CreateOrUpdate(ctx, resGroup, serverName, keyName, key)
+		if err != nil {
+			return fmt.Errorf("Error creating Key %q for SQL Server %q (Resource Group %q): %s", keyName, serverName, resGroup, azure.FormatError(err))
+		}
+
+		if err = keyFuture.WaitForCompletionRef(ctx, keysClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for creation of Key %q for SQL Server %q (Resource Group %q): %s", keyName, serverName, resGroup, azure.FormatError(err))
+		}
+
+		serverKeyName = keyName
+		serverKeyType = sql.AzureKeyVault
+	}
+
+	parameters := sql.EncryptionProtector{
+		EncryptionProtectorProperties: &sql.EncryptionProtectorProperties{
+			ServerKeyName: utils.String(serverKeyName),
+			ServerKeyType: serverKeyType,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, serverName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error issuing create/update request for SQL Server Transparent Data Encryption (Resource Group %q, Server %q): %s", resGroup, serverName, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting on create/update future for SQL Server Transparent Data Encryption (Resource Group %q, Server %q): %s", resGroup, serverName, azure.FormatError(err))
+	}
+
+	resp, err := client.Get(ctx, resGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error issuing get request for SQL Server Transparent Data Encryption (Resource Group %q, Server %q): %s", resGroup, serverName, azure.FormatError(err))
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSqlServerTransparentDataEncryptionRead(d, meta)
+}
+
+func resourceArmSqlServerTransparentDataEncryptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading SQL Server Transparent Data Encryption %q - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading SQL Server Transparent Data Encryption: %s", azure.FormatError(err))
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	keyVaultKeyId := ""
+	if props := resp.EncryptionProtectorProperties; props != nil && props.ServerKeyType == sql.AzureKeyVault && props.URI != nil {
+		keyVaultKeyId = *props.URI
+	}
+	d.Set("key_vault_key_id", keyVaultKeyId)
+
+	return nil
+}
+
+func resourceArmSqlServerTransparentDataEncryptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	// the Encryption Protector can't be removed, so we revert it to a Service Managed key instead
+	// the Server Key is left registered since existing backups may still be encrypted with it
+	parameters := sql.EncryptionProtector{
+		EncryptionProtectorProperties: &sql.EncryptionProtectorProperties{
+			ServerKeyName: utils.String("ServiceManaged"),
+			ServerKeyType: sql.ServiceManaged,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error reverting SQL Server Transparent Data Encryption to a Service Managed key (Resource Group %q, Server %q): %s", resourceGroup, serverName, azure.FormatError(err))
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
+
+// sqlServerKeyNameFromKeyVaultKeyId returns the name a Key Vault Key must be registered under, which is in
+// the format `{vaultName}_{keyName}_{keyVersion}`
+func sqlServerKeyNameFromKeyVaultKeyId(keyVaultKeyId string) (string, error) {
+	keyId, err := azure.ParseKeyVaultChildID(keyVaultKeyId)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing Key Vault Key ID %q: %+v", keyVaultKeyId, err)
+	}
+
+	baseUrl, err := url.Parse(keyId.KeyVaultBaseUrl)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing Key Vault URL %q: %+v", keyId.KeyVaultBaseUrl, err)
+	}
+
+	vaultName := strings.Split(baseUrl.Hostname(), ".")[0]
+
+	return fmt.Sprintf("%s_%s_%s", vaultName, keyId.Name, keyId.Version), nil
+}
@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMSqlServerTransparentDataEncryption_keyVault(t *testing.T) {
+	resourceName := "azurerm_sql_server_transparent_data_encryption.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServerTransparentDataEncryption_keyVault(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerTransparentDataEncryptionType(resourceName, sql.AzureKeyVault),
+					resource.TestCheckResourceAttrSet(resourceName, "key_vault_key_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMSqlServerTransparentDataEncryption_serviceManaged(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerTransparentDataEncryptionType(resourceName, sql.ServiceManaged),
+					resource.TestCheckResourceAttr(resourceName, "key_vault_key_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlServerTransparentDataEncryptionType(resourceName string, keyType sql.ServerKeyType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		serverName := rs.Primary.Attributes["server_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlEncryptionProtectorsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on sqlEncryptionProtectorsClient: %+v", err)
+		}
+
+		if resp.EncryptionProtectorProperties == nil {
+			return fmt.Errorf("Bad: `properties` was nil for the Encryption Protector of SQL Server %q (Resource Group %q)", serverName, resourceGroup)
+		}
+
+		if actual := resp.EncryptionProtectorProperties.ServerKeyType; actual != keyType {
+			return fmt.Errorf("Bad: expected the Encryption Protector of SQL Server %q (Resource Group %q) to be %q but got %q", serverName, resourceGroup, keyType, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSqlServerTransparentDataEncryption_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "acctestkv-%s"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  tenant_id                = "${data.azurerm_client_config.current.tenant_id}"
+  soft_delete_enabled      = true
+  purge_protection_enabled = true
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_access_policy" "server" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${azurerm_sql_server.test.identity.0.tenant_id}"
+  object_id    = "${azurerm_sql_server.test.identity.0.principal_id}"
+
+  key_permissions = [
+    "get",
+    "wrapkey",
+    "unwrapkey",
+  ]
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "unwrapKey",
+    "wrapKey",
+  ]
+}
+`, rInt, location, rInt, rString, rString)
+}
+
+func testAccAzureRMSqlServerTransparentDataEncryption_keyVault(rInt int, rString string, location string) string {
+	template := testAccAzureRMSqlServerTransparentDataEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_transparent_data_encryption" "test" {
+  server_name         = "${azurerm_sql_server.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  key_vault_key_id    = "${azurerm_key_vault_key.test.id}"
+
+  depends_on = ["azurerm_key_vault_access_policy.server"]
+}
+`, template)
+}
+
+func testAccAzureRMSqlServerTransparentDataEncryption_serviceManaged(rInt int, rString string, location string) string {
+	template := testAccAzureRMSqlServerTransparentDataEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_transparent_data_encryption" "test" {
+  server_name         = "${azurerm_sql_server.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, template)
+}
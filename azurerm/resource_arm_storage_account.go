@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-getter/helper/url"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -377,7 +378,7 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Storage Account %q (Resource Group %q): %s", storageAccountName, resourceGroupName, err)
+				return fmt.Errorf("Error checking for presence of existing Storage Account %q (Resource Group %q): %s", storageAccountName, resourceGroupName, azure.FormatError(err))
 			}
 		}
 
@@ -459,16 +460,16 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	// Create
 	future, err := client.Create(ctx, resourceGroupName, storageAccountName, parameters)
 	if err != nil {
-		return fmt.Errorf("Error creating Azure Storage Account %q: %+v", storageAccountName, err)
+		return fmt.Errorf("Error creating Azure Storage Account %q: %s", storageAccountName, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for Azure Storage Account %q to be created: %+v", storageAccountName, err)
+		return fmt.Errorf("Error waiting for Azure Storage Account %q to be created: %s", storageAccountName, azure.FormatError(err))
 	}
 
 	account, err := client.GetProperties(ctx, resourceGroupName, storageAccountName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Azure Storage Account %q: %+v", storageAccountName, err)
+		return fmt.Errorf("Error retrieving Azure Storage Account %q: %s", storageAccountName, azure.FormatError(err))
 	}
 
 	if account.ID == nil {
@@ -517,7 +518,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account type %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account type %q: %s", storageAccountName, azure.FormatError(err))
 		}
 
 		d.SetPartial("account_replication_type")
@@ -533,7 +534,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account access_tier %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account access_tier %q: %s", storageAccountName, azure.FormatError(err))
 		}
 
 		d.SetPartial("access_tier")
@@ -547,7 +548,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account tags %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account tags %q: %s", storageAccountName, azure.FormatError(err))
 		}
 
 		d.SetPartial("tags")
@@ -584,7 +585,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 
 		_, err := client.Update(ctx, resourceGroupName, storageAccountName, opts)
 		if err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account Encryption %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account Encryption %q: %s", storageAccountName, azure.FormatError(err))
 		}
 	}
 
@@ -597,7 +598,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account Custom Domain %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account Custom Domain %q: %s", storageAccountName, azure.FormatError(err))
 		}
 	}
 
@@ -611,7 +612,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account enable_https_traffic_only %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account enable_https_traffic_only %q: %s", storageAccountName, azure.FormatError(err))
 		}
 
 		d.SetPartial("enable_https_traffic_only")
@@ -625,7 +626,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account identity %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account identity %q: %s", storageAccountName, azure.FormatError(err))
 		}
 	}
 
@@ -639,7 +640,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account network_rules %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account network_rules %q: %s", storageAccountName, azure.FormatError(err))
 		}
 
 		d.SetPartial("network_rules")
@@ -667,7 +668,7 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading the state of AzureRM Storage Account %q: %+v", name, err)
+		return fmt.Errorf("Error reading the state of AzureRM Storage Account %q: %s", name, azure.FormatError(err))
 	}
 
 	keys, err := client.ListKeys(ctx, resGroup, name)
@@ -718,12 +719,12 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 
 		if len(accessKeys) > 0 {
 			pcs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *accessKeys[0].Value, endpointSuffix)
-			d.Set("primary_connection_string", pcs)
+			d.Set("primary_connection_string", sensitiveValue(meta, &pcs))
 		}
 
 		if len(accessKeys) > 1 {
 			scs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *accessKeys[1].Value, endpointSuffix)
-			d.Set("secondary_connection_string", scs)
+			d.Set("secondary_connection_string", sensitiveValue(meta, &scs))
 		}
 
 		if err := flattenAndSetAzureRmStorageAccountPrimaryEndpoints(d, props.PrimaryEndpoints); err != nil {
@@ -734,7 +735,7 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		if v := props.PrimaryEndpoints; v != nil {
 			primaryBlobConnectStr = getBlobConnectionString(v.Blob, resp.Name, accessKeys[0].Value)
 		}
-		d.Set("primary_blob_connection_string", primaryBlobConnectStr)
+		d.Set("primary_blob_connection_string", sensitiveValue(meta, &primaryBlobConnectStr))
 
 		if err := flattenAndSetAzureRmStorageAccountSecondaryEndpoints(d, props.SecondaryEndpoints); err != nil {
 			return fmt.Errorf("error setting secondary endpoints and hosts for blob, queue, table: %+v", err)
@@ -744,7 +745,7 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		if v := props.SecondaryEndpoints; v != nil {
 			secondaryBlobConnectStr = getBlobConnectionString(v.Blob, resp.Name, accessKeys[1].Value)
 		}
-		d.Set("secondary_blob_connection_string", secondaryBlobConnectStr)
+		d.Set("secondary_blob_connection_string", sensitiveValue(meta, &secondaryBlobConnectStr))
 
 		networkRules := props.NetworkRuleSet
 		if networkRules != nil {
@@ -754,8 +755,8 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	d.Set("primary_access_key", accessKeys[0].Value)
-	d.Set("secondary_access_key", accessKeys[1].Value)
+	d.Set("primary_access_key", sensitiveValue(meta, accessKeys[0].Value))
+	d.Set("secondary_access_key", sensitiveValue(meta, accessKeys[1].Value))
 
 	identity := flattenAzureRmStorageAccountIdentity(resp.Identity)
 	if err := d.Set("identity", identity); err != nil {
@@ -784,7 +785,7 @@ func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	// the networking api's only allow a single change to be made to a network layout at once, so let's lock to handle that
@@ -815,7 +816,7 @@ func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) e
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("Error issuing delete request for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error issuing delete request for Storage Account %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 		}
 	}
 
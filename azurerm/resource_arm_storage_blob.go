@@ -2,17 +2,20 @@ package azurerm
 
 import (
 	"bytes"
-	"crypto/rand"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"net/url"
 	"os"
 	"runtime"
 	"strings"
 	"sync"
 
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 
@@ -84,14 +87,41 @@ func resourceArmStorageBlob() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_uri"},
+				ConflictsWith: []string{"source_uri", "source_content"},
+			},
+
+			"source_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source", "source_uri"},
 			},
 
 			"source_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source"},
+				ConflictsWith: []string{"source", "source_content"},
+			},
+
+			"content_md5": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.MD5Hash,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"source_uri"},
+			},
+
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.StorageMetaDataKeys,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 
 			"url": {
@@ -139,6 +169,15 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	containerName := d.Get("storage_container_name").(string)
 	sourceUri := d.Get("source_uri").(string)
 	contentType := d.Get("content_type").(string)
+	metaData := expandStorageBlobMetaData(d.Get("metadata").(map[string]interface{}))
+
+	contentMD5, err := convertHexToBase64Encoding(d.Get("content_md5").(string))
+	if err != nil {
+		return fmt.Errorf("Error converting `content_md5` to base64: %+v", err)
+	}
+	if contentMD5 != "" && strings.EqualFold(blobType, "page") {
+		return fmt.Errorf("`content_md5` cannot be specified for Page Blobs")
+	}
 
 	log.Printf("[INFO] Creating blob %q in container %q within storage account %q", name, containerName, storageAccountName)
 	container := blobClient.GetContainerReference(containerName)
@@ -165,17 +204,29 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		switch strings.ToLower(blobType) {
 		case "block":
-			options := &storage.PutBlobOptions{}
-			if err := blob.CreateBlockBlob(options); err != nil {
-				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
-			}
-
 			source := d.Get("source").(string)
 			if source != "" {
 				parallelism := d.Get("parallelism").(int)
 				attempts := d.Get("attempts").(int)
 
-				if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
+				// the Block Blob is created when the Block List is committed, since creating it up-front would
+				// discard any uncommitted blocks from a previous (failed) upload which can otherwise be resumed
+				if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, contentType, contentMD5, metaData, blobClient, parallelism, attempts); err != nil {
+					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+				}
+			} else {
+				blob.Properties.ContentType = contentType
+				blob.Properties.ContentMD5 = contentMD5
+				blob.Metadata = metaData
+
+				content := d.Get("source_content").(string)
+				if contentMD5 == "" && content != "" {
+					hash := md5.Sum([]byte(content))
+					blob.Properties.ContentMD5 = base64.StdEncoding.EncodeToString(hash[:])
+				}
+
+				options := &storage.PutBlobOptions{}
+				if err := blob.CreateBlockBlobFromReader(strings.NewReader(content), options); err != nil {
 					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 				}
 			}
@@ -201,6 +252,14 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	// Blobs copied from another Blob or uploaded as Pages retain their own MetaData, so it needs to be set separately
+	if len(metaData) > 0 && (sourceUri != "" || strings.EqualFold(blobType, "page")) {
+		blob.Metadata = metaData
+		if err := blob.SetMetadata(&storage.SetBlobMetadataOptions{}); err != nil {
+			return fmt.Errorf("Error setting MetaData for Blob %q (Container %q / Account %q / Resource Group %q): %s", name, containerName, storageAccountName, resourceGroupName, err)
+		}
+	}
+
 	d.SetId(id)
 	return resourceArmStorageBlobRead(d, meta)
 }
@@ -384,11 +443,11 @@ func resourceArmStorageBlobPageUploadWorker(ctx resourceArmStorageBlobPageUpload
 }
 
 type resourceArmStorageBlobBlock struct {
+	index   int
 	section *io.SectionReader
-	id      string
 }
 
-func resourceArmStorageBlobBlockUploadFromSource(container, name, source, contentType string, client *storage.BlobStorageClient, parallelism, attempts int) error {
+func resourceArmStorageBlobBlockUploadFromSource(container, name, source, contentType, contentMD5 string, metaData storage.BlobMetadata, client *storage.BlobStorageClient, parallelism, attempts int) error {
 	workerCount := parallelism * runtime.NumCPU()
 
 	file, err := os.Open(source)
@@ -397,14 +456,37 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	}
 	defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing Storage Blob `%s` file `%s` after upload", name, source))
 
-	blockList, parts, err := resourceArmStorageBlobBlockSplit(file)
+	parts, err := resourceArmStorageBlobBlockSplit(file)
 	if err != nil {
 		return fmt.Errorf("Error reading and splitting source file for upload %q: %s", source, err)
 	}
 
+	if contentMD5 == "" {
+		hash := md5.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(file, 0, math.MaxInt64)); err != nil {
+			return fmt.Errorf("Error calculating the MD5 hash of source file %q: %s", source, err)
+		}
+		contentMD5 = base64.StdEncoding.EncodeToString(hash.Sum(nil))
+	}
+
+	containerReference := client.GetContainerReference(container)
+	blobReference := containerReference.GetBlobReference(name)
+
+	// any blocks uploaded by a previous (failed) upload of the same file can be re-used, since the Block ID is
+	// derived from the position and contents of the block - the API returns a 404 when the blob doesn't exist
+	uploaded := make(map[string]int64)
+	if existing, err := blobReference.GetBlockList(storage.BlockListTypeUncommitted, &storage.GetBlockListOptions{}); err == nil {
+		for _, block := range existing.UncommittedBlocks {
+			uploaded[block.Name] = block.Size
+		}
+	} else {
+		log.Printf("[DEBUG] Unable to retrieve the uncommitted blocks for Blob %q (Container %q) - uploading all blocks: %s", name, container, err)
+	}
+
 	wg := &sync.WaitGroup{}
 	blocks := make(chan resourceArmStorageBlobBlock, len(parts))
 	errors := make(chan error, len(parts))
+	blockList := make([]storage.Block, len(parts))
 
 	wg.Add(len(parts))
 	for _, p := range parts {
@@ -419,6 +501,8 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 			container: container,
 			name:      name,
 			blocks:    blocks,
+			blockList: blockList,
+			uploaded:  uploaded,
 			errors:    errors,
 			wg:        wg,
 			attempts:  attempts,
@@ -431,9 +515,9 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 		return fmt.Errorf("Error while uploading source file %q: %s", source, <-errors)
 	}
 
-	containerReference := client.GetContainerReference(container)
-	blobReference := containerReference.GetBlobReference(name)
 	blobReference.Properties.ContentType = contentType
+	blobReference.Properties.ContentMD5 = contentMD5
+	blobReference.Metadata = metaData
 	options := &storage.PutBlockListOptions{}
 	err = blobReference.PutBlockList(blockList, options)
 	if err != nil {
@@ -443,46 +527,36 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	return nil
 }
 
-func resourceArmStorageBlobBlockSplit(file *os.File) ([]storage.Block, []resourceArmStorageBlobBlock, error) {
-	const (
-		idSize          = 64
-		blockSize int64 = 4 * 1024 * 1024
-	)
+func resourceArmStorageBlobBlockSplit(file *os.File) ([]resourceArmStorageBlobBlock, error) {
+	const blockSize int64 = 4 * 1024 * 1024
 	var parts []resourceArmStorageBlobBlock
-	var blockList []storage.Block
 
 	info, err := file.Stat()
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error stating source file %q: %s", file.Name(), err)
+		return nil, fmt.Errorf("Error stating source file %q: %s", file.Name(), err)
 	}
 
 	for i := int64(0); i < info.Size(); i = i + blockSize {
-		entropy := make([]byte, idSize)
-		_, err = rand.Read(entropy)
-		if err != nil {
-			return nil, nil, fmt.Errorf("Error generating a random block ID for source file %q: %s", file.Name(), err)
-		}
-
 		sectionSize := blockSize
 		remainder := info.Size() - i
 		if remainder < blockSize {
 			sectionSize = remainder
 		}
 
-		block := storage.Block{
-			ID:     base64.StdEncoding.EncodeToString(entropy),
-			Status: storage.BlockStatusUncommitted,
-		}
-
-		blockList = append(blockList, block)
-
 		parts = append(parts, resourceArmStorageBlobBlock{
-			id:      block.ID,
+			index:   len(parts),
 			section: io.NewSectionReader(file, i, sectionSize),
 		})
 	}
 
-	return blockList, parts, nil
+	return parts, nil
+}
+
+// resourceArmStorageBlobBlockID returns the ID for a Block, which is derived from the position and contents of
+// the Block so that it's stable across uploads. Block ID's must be the same length for all Blocks within a Blob.
+func resourceArmStorageBlobBlockID(index int, content []byte) string {
+	hash := md5.Sum(content)
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d-%x", index, hash)))
 }
 
 type resourceArmStorageBlobBlockUploadContext struct {
@@ -492,6 +566,8 @@ type resourceArmStorageBlobBlockUploadContext struct {
 	source    string
 	attempts  int
 	blocks    chan resourceArmStorageBlobBlock
+	blockList []storage.Block
+	uploaded  map[string]int64
 	errors    chan error
 	wg        *sync.WaitGroup
 }
@@ -507,16 +583,28 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 			continue
 		}
 
+		id := resourceArmStorageBlobBlockID(block.index, buffer)
+		ctx.blockList[block.index] = storage.Block{
+			ID:     id,
+			Status: storage.BlockStatusUncommitted,
+		}
+
+		if size, ok := ctx.uploaded[id]; ok && size == int64(len(buffer)) {
+			log.Printf("[DEBUG] Block %q for source file %q has already been uploaded - skipping", id, ctx.source)
+			ctx.wg.Done()
+			continue
+		}
+
 		for i := 0; i < ctx.attempts; i++ {
 			container := ctx.client.GetContainerReference(ctx.container)
 			blob := container.GetBlobReference(ctx.name)
 			options := &storage.PutBlockOptions{}
-			if err = blob.PutBlock(block.id, buffer, options); err == nil {
+			if err = blob.PutBlock(id, buffer, options); err == nil {
 				break
 			}
 		}
 		if err != nil {
-			ctx.errors <- fmt.Errorf("Error uploading block %q for source file %q: %s", block.id, ctx.source, err)
+			ctx.errors <- fmt.Errorf("Error uploading block %q for source file %q: %s", id, ctx.source, err)
 			ctx.wg.Done()
 			continue
 		}
@@ -555,16 +643,30 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 	blob := container.GetBlobReference(id.blobName)
 
 	if d.HasChange("content_type") {
+		// Set Blob Properties overwrites all of the properties, so we need to retrieve the existing ones first
+		if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+			return fmt.Errorf("Error getting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
+
 		blob.Properties.ContentType = d.Get("content_type").(string)
+
+		options := &storage.SetBlobPropertiesOptions{}
+		err = blob.SetProperties(options)
+		if err != nil {
+			return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
 	}
 
-	options := &storage.SetBlobPropertiesOptions{}
-	err = blob.SetProperties(options)
-	if err != nil {
-		return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+	if d.HasChange("metadata") {
+		blob.Metadata = expandStorageBlobMetaData(d.Get("metadata").(map[string]interface{}))
+
+		options := &storage.SetBlobMetadataOptions{}
+		if err := blob.SetMetadata(options); err != nil {
+			return fmt.Errorf("Error setting metadata of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
 	}
 
-	return nil
+	return resourceArmStorageBlobRead(d, meta)
 }
 
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
@@ -622,6 +724,16 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("content_type", blob.Properties.ContentType)
 
+	contentMD5, err := convertBase64ToHexEncoding(blob.Properties.ContentMD5)
+	if err != nil {
+		return fmt.Errorf("Error converting the Content MD5 of blob %s (container %s, storage account %s) to hex: %+v", id.blobName, id.containerName, id.storageAccountName, err)
+	}
+	d.Set("content_md5", contentMD5)
+
+	if err := d.Set("metadata", flattenStorageBlobMetaData(blob.Metadata)); err != nil {
+		return fmt.Errorf("Error setting `metadata`: %+v", err)
+	}
+
 	d.Set("source_uri", blob.Properties.CopySource)
 
 	blobType := strings.ToLower(strings.Replace(string(blob.Properties.BlobType), "Blob", "", 1))
@@ -730,3 +842,46 @@ func determineResourceGroupForStorageAccount(accountName string, client *ArmClie
 
 	return nil, nil
 }
+
+func expandStorageBlobMetaData(input map[string]interface{}) storage.BlobMetadata {
+	output := make(storage.BlobMetadata)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return output
+}
+
+func flattenStorageBlobMetaData(input storage.BlobMetadata) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		output[k] = v
+	}
+	return output
+}
+
+// the API uses a base64 encoded MD5 hash, whereas Terraform's `md5` function returns a hex encoded MD5 hash
+func convertHexToBase64Encoding(input string) (string, error) {
+	if input == "" {
+		return "", nil
+	}
+
+	decoded, err := hex.DecodeString(input)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(decoded), nil
+}
+
+func convertBase64ToHexEncoding(input string) (string, error) {
+	if input == "" {
+		return "", nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(decoded), nil
+}
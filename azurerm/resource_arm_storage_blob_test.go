@@ -277,6 +277,117 @@ func TestAccAzureRMStorageBlobBlock_blockContentType(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlobBlock_sourceContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlobBlock_sourceContent(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					// md5("Hello, World!")
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "65a8e27d8879283831b664bd8b7f0ad4"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "source_content", "type"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlob_metadata(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlob_metadata(ri, rs, location, "hello", "world"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "world"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageBlob_metadata(ri, rs, location, "panda", "pops"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.panda", "pops"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "type"},
+			},
+		},
+	})
+}
+
+func TestResourceArmStorageBlobMD5Encoding(t *testing.T) {
+	hexEncoded := "65a8e27d8879283831b664bd8b7f0ad4"
+
+	base64Encoded, err := convertHexToBase64Encoding(hexEncoded)
+	if err != nil {
+		t.Fatalf("Error converting %q to base64: %+v", hexEncoded, err)
+	}
+	if base64Encoded != "ZajifYh5KDgxtmS9i38K1A==" {
+		t.Fatalf("Expected %q to be base64 encoded as %q but got %q", hexEncoded, "ZajifYh5KDgxtmS9i38K1A==", base64Encoded)
+	}
+
+	actual, err := convertBase64ToHexEncoding(base64Encoded)
+	if err != nil {
+		t.Fatalf("Error converting %q to hex: %+v", base64Encoded, err)
+	}
+	if actual != hexEncoded {
+		t.Fatalf("Expected %q to be hex encoded as %q but got %q", base64Encoded, hexEncoded, actual)
+	}
+
+	if _, err := convertHexToBase64Encoding("not-hex"); err == nil {
+		t.Fatalf("Expected an error converting an invalid hex value but didn't get one")
+	}
+}
+
+func TestResourceArmStorageBlobBlockID(t *testing.T) {
+	first := resourceArmStorageBlobBlockID(0, []byte("hello"))
+	if first != resourceArmStorageBlobBlockID(0, []byte("hello")) {
+		t.Fatalf("Expected the Block ID to be stable for the same position and contents")
+	}
+
+	if first == resourceArmStorageBlobBlockID(1, []byte("hello")) {
+		t.Fatalf("Expected the Block ID to differ for a different position")
+	}
+
+	if first == resourceArmStorageBlobBlockID(0, []byte("world")) {
+		t.Fatalf("Expected the Block ID to differ for different contents")
+	}
+
+	// all of the Block ID's within a Blob must be the same length
+	if len(first) != len(resourceArmStorageBlobBlockID(49999, make([]byte, 4*1024*1024))) {
+		t.Fatalf("Expected all Block ID's to be the same length")
+	}
+}
+
 func testCheckAzureRMStorageBlobExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -684,3 +795,75 @@ resource "azurerm_storage_blob" "source" {
 }
 `, rInt, location, rString, sourceBlobName, contentType)
 }
+
+func testAccAzureRMStorageBlobBlock_sourceContent(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "content"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "block"
+  source_content         = "Hello, World!"
+  content_type           = "text/plain"
+  content_md5            = "${md5("Hello, World!")}"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageBlob_metadata(rInt int, rString string, location string, key, value string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "herpderp1.vhd"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "page"
+  size                   = 5120
+
+  metadata = {
+    %s = "%s"
+  }
+}
+`, rInt, location, rString, key, value)
+}
@@ -10,12 +10,14 @@ import (
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
 func resourceArmStorageQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageQueueCreate,
 		Read:   resourceArmStorageQueueRead,
+		Update: resourceArmStorageQueueUpdate,
 		Delete: resourceArmStorageQueueDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -36,6 +38,14 @@ func resourceArmStorageQueue() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validate.StorageMetaDataKeys,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -100,6 +110,7 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	log.Printf("[INFO] Creating queue %q in storage account %q", name, storageAccountName)
+	queueReference.Metadata = expandStorageQueueMetaData(d.Get("metadata").(map[string]interface{}))
 	options := &storage.QueueServiceOptions{}
 	err = queueReference.Create(options)
 	if err != nil {
@@ -153,13 +164,59 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 		return nil
 	}
 
+	if err := queueReference.GetMetadata(&storage.QueueServiceOptions{}); err != nil {
+		return fmt.Errorf("Error retrieving MetaData for storage queue %q: %s", id.queueName, err)
+	}
+
 	d.Set("name", id.queueName)
 	d.Set("storage_account_name", id.storageAccountName)
 	d.Set("resource_group_name", *resourceGroup)
 
+	if err := d.Set("metadata", flattenStorageQueueMetaData(queueReference.Metadata)); err != nil {
+		return fmt.Errorf("Error setting `metadata`: %+v", err)
+	}
+
 	return nil
 }
 
+func resourceArmStorageQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageQueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	if err != nil {
+		return err
+	}
+
+	if resourceGroup == nil {
+		return fmt.Errorf("Unable to determine Resource Group for Storage Account %q", id.storageAccountName)
+	}
+
+	queueClient, accountExists, err := armClient.getQueueServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) was not found", id.storageAccountName, *resourceGroup)
+	}
+
+	if d.HasChange("metadata") {
+		log.Printf("[INFO] Updating MetaData for storage queue %q", id.queueName)
+		queueReference := queueClient.GetQueueReference(id.queueName)
+		queueReference.Metadata = expandStorageQueueMetaData(d.Get("metadata").(map[string]interface{}))
+		if err := queueReference.SetMetadata(&storage.QueueServiceOptions{}); err != nil {
+			return fmt.Errorf("Error updating MetaData for storage queue %q: %s", id.queueName, err)
+		}
+	}
+
+	return resourceArmStorageQueueRead(d, meta)
+}
+
 func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	ctx := armClient.StopContext
@@ -224,3 +281,19 @@ func parseStorageQueueID(input string) (*storageQueueId, error) {
 
 	return nil, nil
 }
+
+func expandStorageQueueMetaData(input map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return output
+}
+
+func flattenStorageQueueMetaData(input map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		output[k] = v
+	}
+	return output
+}
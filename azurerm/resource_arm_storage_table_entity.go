@@ -0,0 +1,277 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func resourceArmStorageTableEntity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageTableEntityCreateUpdate,
+		Read:   resourceArmStorageTableEntityRead,
+		Update: resourceArmStorageTableEntityCreateUpdate,
+		Delete: resourceArmStorageTableEntityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageTableName,
+			},
+
+			"storage_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"partition_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"row_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"entity": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStorageTableEntityCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+	environment := armClient.environment
+
+	storageAccountName := d.Get("storage_account_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tableName := d.Get("table_name").(string)
+	partitionKey := d.Get("partition_key").(string)
+	rowKey := d.Get("row_key").(string)
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) was not found", storageAccountName, resourceGroup)
+	}
+
+	table := tableClient.GetTableReference(tableName)
+	entity := table.GetEntityReference(partitionKey, rowKey)
+	id := fmt.Sprintf("https://%s.table.%s/%s(PartitionKey='%s',RowKey='%s')", storageAccountName, environment.StorageEndpointSuffix, tableName, partitionKey, rowKey)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing := table.GetEntityReference(partitionKey, rowKey)
+		if err := existing.Get(60, storage.MinimalMetadata, &storage.GetEntityOptions{}); err != nil {
+			if !storageTableEntityWasNotFound(err) {
+				return fmt.Errorf("Error checking for presence of existing Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q / Resource Group %q): %s", partitionKey, rowKey, tableName, storageAccountName, resourceGroup, err)
+			}
+		} else {
+			return tf.ImportAsExistsError("azurerm_storage_table_entity", id)
+		}
+	}
+
+	entity.Properties = expandStorageTableEntityProperties(d.Get("entity").(map[string]interface{}))
+
+	log.Printf("[INFO] Inserting/Replacing Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q)", partitionKey, rowKey, tableName, storageAccountName)
+	options := &storage.EntityOptions{
+		Timeout: 60,
+	}
+	if err := entity.InsertOrReplace(options); err != nil {
+		return fmt.Errorf("Error inserting/replacing Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q / Resource Group %q): %s", partitionKey, rowKey, tableName, storageAccountName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmStorageTableEntityRead(d, meta)
+}
+
+func resourceArmStorageTableEntityRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageTableEntityID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	if err != nil {
+		return err
+	}
+
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to determine Resource Group for Storage Account %q (assuming removed) - removing from state", id.storageAccountName)
+		d.SetId("")
+		return nil
+	}
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+
+	if !accountExists {
+		log.Printf("[DEBUG] Storage Account %q not found, removing Entity (Partition Key %q / Row Key %q) from state", id.storageAccountName, id.partitionKey, id.rowKey)
+		d.SetId("")
+		return nil
+	}
+
+	table := tableClient.GetTableReference(id.tableName)
+	entity := table.GetEntityReference(id.partitionKey, id.rowKey)
+	if err := entity.Get(60, storage.MinimalMetadata, &storage.GetEntityOptions{}); err != nil {
+		if storageTableEntityWasNotFound(err) {
+			log.Printf("[DEBUG] Entity (Partition Key %q / Row Key %q) was not found in Table %q (Storage Account %q) - removing from state", id.partitionKey, id.rowKey, id.tableName, id.storageAccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q / Resource Group %q): %s", id.partitionKey, id.rowKey, id.tableName, id.storageAccountName, *resourceGroup, err)
+	}
+
+	d.Set("storage_account_name", id.storageAccountName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("table_name", id.tableName)
+	d.Set("partition_key", id.partitionKey)
+	d.Set("row_key", id.rowKey)
+
+	if err := d.Set("entity", flattenStorageTableEntityProperties(entity.Properties)); err != nil {
+		return fmt.Errorf("Error setting `entity`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageTableEntityDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageTableEntityID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	if err != nil {
+		return err
+	}
+
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to determine Resource Group for Storage Account %q (assuming removed)", id.storageAccountName)
+		return nil
+	}
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[INFO] Storage Account %q doesn't exist so the Entity won't exist", id.storageAccountName)
+		return nil
+	}
+
+	table := tableClient.GetTableReference(id.tableName)
+	entity := table.GetEntityReference(id.partitionKey, id.rowKey)
+
+	log.Printf("[INFO] Deleting Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q)", id.partitionKey, id.rowKey, id.tableName, id.storageAccountName)
+	options := &storage.EntityOptions{
+		Timeout: 60,
+	}
+	if err := entity.Delete(true, options); err != nil {
+		if storageTableEntityWasNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q / Resource Group %q): %s", id.partitionKey, id.rowKey, id.tableName, id.storageAccountName, *resourceGroup, err)
+	}
+
+	return nil
+}
+
+func storageTableEntityWasNotFound(err error) bool {
+	if e, ok := err.(storage.AzureStorageServiceError); ok {
+		return e.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
+
+type storageTableEntityId struct {
+	storageAccountName string
+	tableName          string
+	partitionKey       string
+	rowKey             string
+}
+
+func parseStorageTableEntityID(input string) (*storageTableEntityId, error) {
+	// https://myaccount.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row1')
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a URI: %+v", input, err)
+	}
+
+	segments := strings.Split(uri.Host, ".")
+	if len(segments) < 2 || segments[0] == "" {
+		return nil, fmt.Errorf("Error parsing Storage Account Name from %q", input)
+	}
+
+	path := strings.TrimPrefix(uri.Path, "/")
+	matches := regexp.MustCompile(`^([^(/]+)\(PartitionKey='(.*)',\s*RowKey='(.*)'\)$`).FindStringSubmatch(path)
+	if len(matches) != 4 {
+		return nil, fmt.Errorf("Error parsing Table Name, Partition Key and Row Key from %q", input)
+	}
+
+	id := storageTableEntityId{
+		storageAccountName: segments[0],
+		tableName:          matches[1],
+		partitionKey:       matches[2],
+		rowKey:             matches[3],
+	}
+	return &id, nil
+}
+
+func expandStorageTableEntityProperties(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return output
+}
+
+func flattenStorageTableEntityProperties(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		// values are written as strings, however entities created outside of Terraform may contain typed values
+		output[k] = fmt.Sprintf("%v", v)
+	}
+	return output
+}
@@ -0,0 +1,343 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestParseStorageTableEntityID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected *storageTableEntityId
+	}{
+		{
+			Input:    "https://account1.table.core.windows.net/table1",
+			Expected: nil,
+		},
+		{
+			Input:    "https://account1.table.core.windows.net/table1(PartitionKey='partition1')",
+			Expected: nil,
+		},
+		{
+			Input: "https://account1.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row1')",
+			Expected: &storageTableEntityId{
+				storageAccountName: "account1",
+				tableName:          "table1",
+				partitionKey:       "partition1",
+				rowKey:             "row1",
+			},
+		},
+		{
+			Input: "https://account1.table.core.chinacloudapi.cn/table1(PartitionKey='partition1', RowKey='row1')",
+			Expected: &storageTableEntityId{
+				storageAccountName: "account1",
+				tableName:          "table1",
+				partitionKey:       "partition1",
+				rowKey:             "row1",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseStorageTableEntityID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestFlattenStorageTableEntityProperties(t *testing.T) {
+	input := map[string]interface{}{
+		"Name":    "Example",
+		"Count":   float64(42),
+		"Enabled": true,
+		"Big":     int64(9007199254740993),
+	}
+	expected := map[string]string{
+		"Name":    "Example",
+		"Count":   "42",
+		"Enabled": "true",
+		"Big":     "9007199254740993",
+	}
+
+	actual := flattenStorageTableEntityProperties(input)
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d items but got %d", len(expected), len(actual))
+	}
+
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Fatalf("Expected %q for %q but got %q", v, k, actual[k])
+		}
+	}
+}
+
+func TestAccAzureRMStorageTableEntity_basic(t *testing.T) {
+	resourceName := "azurerm_storage_table_entity.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageTableEntity_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entity.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entity.Foo", "Bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageTableEntity_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_table_entity.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageTableEntity_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageTableEntity_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_table_entity"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageTableEntity_update(t *testing.T) {
+	resourceName := "azurerm_storage_table_entity.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageTableEntity_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entity.%", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageTableEntity_updated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entity.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entity.Foo", "Bar"),
+					resource.TestCheckResourceAttr(resourceName, "entity.Test", "Updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageTableEntityExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		tableName := rs.Primary.Attributes["table_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		partitionKey := rs.Primary.Attributes["partition_key"]
+		rowKey := rs.Primary.Attributes["row_key"]
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		}
+
+		entity := tableClient.GetTableReference(tableName).GetEntityReference(partitionKey, rowKey)
+		if err := entity.Get(60, storage.MinimalMetadata, &storage.GetEntityOptions{}); err != nil {
+			if storageTableEntityWasNotFound(err) {
+				return fmt.Errorf("Bad: Entity (Partition Key %q / Row Key %q) does not exist in Table %q (Storage Account %q)", partitionKey, rowKey, tableName, storageAccountName)
+			}
+
+			return fmt.Errorf("Error retrieving Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q): %+v", partitionKey, rowKey, tableName, storageAccountName, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageTableEntityDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_table_entity" {
+			continue
+		}
+
+		tableName := rs.Primary.Attributes["table_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		partitionKey := rs.Primary.Attributes["partition_key"]
+		rowKey := rs.Primary.Attributes["row_key"]
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			// if we can't get the keys then the entity can't exist
+			return nil
+		}
+		if !accountExists {
+			return nil
+		}
+
+		entity := tableClient.GetTableReference(tableName).GetEntityReference(partitionKey, rowKey)
+		if err := entity.Get(60, storage.MinimalMetadata, &storage.GetEntityOptions{}); err != nil {
+			// the table (or the entity) no longer exists
+			return nil
+		}
+
+		return fmt.Errorf("Bad: Entity (Partition Key %q / Row Key %q) still exists in Table %q (Storage Account %q)", partitionKey, rowKey, tableName, storageAccountName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageTableEntity_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageTableEntity_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  table_name           = "${azurerm_storage_table.test.name}"
+
+  partition_key = "test_partition%d"
+  row_key       = "test_row%d"
+
+  entity = {
+    Foo = "Bar"
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMStorageTableEntity_updated(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageTableEntity_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  table_name           = "${azurerm_storage_table.test.name}"
+
+  partition_key = "test_partition%d"
+  row_key       = "test_row%d"
+
+  entity = {
+    Foo  = "Bar"
+    Test = "Updated"
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMStorageTableEntity_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageTableEntity_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "import" {
+  storage_account_name = "${azurerm_storage_table_entity.test.storage_account_name}"
+  resource_group_name  = "${azurerm_storage_table_entity.test.resource_group_name}"
+  table_name           = "${azurerm_storage_table_entity.test.table_name}"
+
+  partition_key = "${azurerm_storage_table_entity.test.partition_key}"
+  row_key       = "${azurerm_storage_table_entity.test.row_key}"
+
+  entity = {
+    Foo = "Bar"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageTableEntity_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, rInt, location, rString, rInt)
+}
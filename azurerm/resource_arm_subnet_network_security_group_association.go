@@ -3,10 +3,12 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -22,17 +24,19 @@ func resourceArmSubnetNetworkSecurityGroupAssociation() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"subnet_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"network_security_group_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 		},
 	}
@@ -47,25 +51,21 @@ func resourceArmSubnetNetworkSecurityGroupAssociationCreate(d *schema.ResourceDa
 	subnetId := d.Get("subnet_id").(string)
 	networkSecurityGroupId := d.Get("network_security_group_id").(string)
 
-	parsedSubnetId, err := parseAzureResourceID(subnetId)
+	parsedSubnetId, err := azure.ParseSubnetID(subnetId)
 	if err != nil {
 		return err
 	}
 
-	networkSecurityGroupName, err := parseNetworkSecurityGroupName(networkSecurityGroupId)
-	if err != nil {
-		return err
-	}
-
-	azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
-	defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+	azureRMLockByID(networkSecurityGroupId)
+	defer azureRMUnlockByID(networkSecurityGroupId)
 
-	subnetName := parsedSubnetId.Path["subnets"]
-	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
+	subnetName := parsedSubnetId.Name
+	virtualNetworkName := parsedSubnetId.VirtualNetworkName
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	azureRMLockByName(virtualNetworkName, virtualNetworkResourceName)
-	defer azureRMUnlockByName(virtualNetworkName, virtualNetworkResourceName)
+	// this locks on both the Virtual Network and the Subnet
+	azureRMLockByID(subnetId)
+	defer azureRMUnlockByID(subnetId)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -73,7 +73,7 @@ func resourceArmSubnetNetworkSecurityGroupAssociationCreate(d *schema.ResourceDa
 			return fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) was not found!", subnetName, virtualNetworkName, resourceGroup)
 		}
 
-		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %s", subnetName, virtualNetworkName, resourceGroup, azure.FormatError(err))
 	}
 
 	if props := subnet.SubnetPropertiesFormat; props != nil {
@@ -91,18 +91,21 @@ func resourceArmSubnetNetworkSecurityGroupAssociationCreate(d *schema.ResourceDa
 		}
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, subnet)
-	if err != nil {
-		return fmt.Errorf("Error updating Route Table Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
-	}
+	err = azureRMRetryWhileParentResourceIsBusy(func() error {
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, subnet)
+		if err != nil {
+			return err
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Route Table Association for Subnet %q (VN %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+		return future.WaitForCompletionRef(ctx, client.Client)
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Network Security Group Association for Subnet %q (Virtual Network %q / Resource Group %q): %s", subnetName, virtualNetworkName, resourceGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
-		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %s", subnetName, virtualNetworkName, resourceGroup, azure.FormatError(err))
 	}
 
 	d.SetId(*read.ID)
@@ -114,13 +117,13 @@ func resourceArmSubnetNetworkSecurityGroupAssociationRead(d *schema.ResourceData
 	client := meta.(*ArmClient).subnetClient
 	ctx := meta.(*ArmClient).StopContext
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	virtualNetworkName := id.Path["virtualNetworks"]
-	subnetName := id.Path["subnets"]
+	virtualNetworkName := id.VirtualNetworkName
+	subnetName := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 
@@ -130,7 +133,7 @@ func resourceArmSubnetNetworkSecurityGroupAssociationRead(d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %s", subnetName, virtualNetworkName, resourceGroup, azure.FormatError(err))
 	}
 
 	props := resp.SubnetPropertiesFormat
@@ -155,15 +158,23 @@ func resourceArmSubnetNetworkSecurityGroupAssociationDelete(d *schema.ResourceDa
 	client := meta.(*ArmClient).subnetClient
 	ctx := meta.(*ArmClient).StopContext
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	virtualNetworkName := id.Path["virtualNetworks"]
-	subnetName := id.Path["subnets"]
+	virtualNetworkName := id.VirtualNetworkName
+	subnetName := id.Name
+
+	networkSecurityGroupId := d.Get("network_security_group_id").(string)
+
+	// this locks on both the Network Security Group and the Virtual Network/Subnet
+	azureRMLockByID(networkSecurityGroupId)
+	defer azureRMUnlockByID(networkSecurityGroupId)
+
+	azureRMLockByID(d.Id())
+	defer azureRMUnlockByID(d.Id())
 
-	// retrieve the subnet
 	read, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
@@ -171,7 +182,7 @@ func resourceArmSubnetNetworkSecurityGroupAssociationDelete(d *schema.ResourceDa
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %s", subnetName, virtualNetworkName, resourceGroup, azure.FormatError(err))
 	}
 
 	props := read.SubnetPropertiesFormat
@@ -184,41 +195,24 @@ func resourceArmSubnetNetworkSecurityGroupAssociationDelete(d *schema.ResourceDa
 		return nil
 	}
 
-	// once we have the network security group id to lock on, lock on that
-	networkSecurityGroupName, err := parseNetworkSecurityGroupName(*props.NetworkSecurityGroup.ID)
-	if err != nil {
-		return err
+	// if the Subnet has since been associated with a different Network Security Group, that's not ours to remove
+	if !strings.EqualFold(*props.NetworkSecurityGroup.ID, networkSecurityGroupId) {
+		log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) is associated with a different Network Security Group (%q) - removing from state!", subnetName, virtualNetworkName, resourceGroup, *props.NetworkSecurityGroup.ID)
+		return nil
 	}
 
-	azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
-	defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
-
-	azureRMLockByName(virtualNetworkName, virtualNetworkResourceName)
-	defer azureRMUnlockByName(virtualNetworkName, virtualNetworkResourceName)
-
-	azureRMLockByName(subnetName, subnetResourceName)
-	defer azureRMUnlockByName(subnetName, subnetResourceName)
+	props.NetworkSecurityGroup = nil
 
-	// then re-retrieve it to ensure we've got the latest state
-	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(read.Response) {
-			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", subnetName, virtualNetworkName, resourceGroup)
-			return nil
+	err = azureRMRetryWhileParentResourceIsBusy(func() error {
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, read)
+		if err != nil {
+			return err
 		}
 
-		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
-	}
-
-	read.SubnetPropertiesFormat.NetworkSecurityGroup = nil
-
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, read)
+		return future.WaitForCompletionRef(ctx, client.Client)
+	})
 	if err != nil {
-		return fmt.Errorf("Error removing Network Security Group Association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for removal of Network Security Group Association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+		return fmt.Errorf("Error removing Network Security Group Association from Subnet %q (Virtual Network %q / Resource Group %q): %s", subnetName, virtualNetworkName, resourceGroup, azure.FormatError(err))
 	}
 
 	return nil
@@ -13,6 +13,9 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"golang.org/x/net/context"
@@ -20,12 +23,25 @@ import (
 
 var virtualMachineResourceName = "azurerm_virtual_machine"
 
+const (
+	virtualMachineShutdownPowerOff   = "PowerOff"
+	virtualMachineShutdownDeallocate = "Deallocate"
+)
+
+const (
+	virtualMachinePowerStateRunning     = "running"
+	virtualMachinePowerStateStopped     = "stopped"
+	virtualMachinePowerStateDeallocated = "deallocated"
+)
+
 func resourceArmVirtualMachine() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmVirtualMachineCreateUpdate,
 		Read:   resourceArmVirtualMachineRead,
 		Update: resourceArmVirtualMachineCreateUpdate,
 		Delete: resourceArmVirtualMachineDelete,
+
+		CustomizeDiff: azureRmVirtualMachineCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -110,6 +126,12 @@ func resourceArmVirtualMachine() *schema.Resource {
 				},
 			},
 
+			"aad_login_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"license_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -203,11 +225,12 @@ func resourceArmVirtualMachine() *schema.Resource {
 						},
 
 						"managed_disk_id": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							ForceNew:      true,
-							Computed:      true,
-							ConflictsWith: []string{"storage_os_disk.0.vhd_uri"},
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Computed:         true,
+							ConflictsWith:    []string{"storage_os_disk.0.vhd_uri"},
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"managed_disk_type": {
@@ -251,6 +274,13 @@ func resourceArmVirtualMachine() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"ephemeral": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -334,6 +364,32 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Default:  false,
 			},
 
+			"delete_network_interfaces_on_termination": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"shutdown_before_deletion": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					virtualMachineShutdownPowerOff,
+					virtualMachineShutdownDeallocate,
+				}, false),
+			},
+
+			"power_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					virtualMachinePowerStateRunning,
+					virtualMachinePowerStateStopped,
+					virtualMachinePowerStateDeallocated,
+				}, false),
+			},
+
 			"boot_diagnostics": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -376,6 +432,12 @@ func resourceArmVirtualMachine() *schema.Resource {
 							Sensitive: true,
 						},
 
+						"admin_password_key_vault_secret_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateKeyVaultChildId,
+						},
+
 						"custom_data": {
 							Type:      schema.TypeString,
 							ForceNew:  true,
@@ -512,8 +574,9 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"source_vault_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"vault_certificates": {
@@ -545,8 +608,9 @@ func resourceArmVirtualMachine() *schema.Resource {
 			},
 
 			"primary_network_interface_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"tags": tagsSchema(),
@@ -567,7 +631,7 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Virtual Machine %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Virtual Machine %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -632,6 +696,16 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 		if err2 != nil {
 			return err2
 		}
+
+		// the password is retrieved from Key Vault at apply time, so that it's not in the configuration
+		osProfileRaw := d.Get("os_profile").(*schema.Set).List()[0].(map[string]interface{})
+		if secretId := osProfileRaw["admin_password_key_vault_secret_id"].(string); secretId != "" {
+			adminPassword, err := azure.GetKeyVaultSecretValue(ctx, meta.(*ArmClient).keyVaultManagementClient, secretId)
+			if err != nil {
+				return fmt.Errorf("Error retrieving the Admin Password for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+			osProfile.AdminPassword = utils.String(adminPassword)
+		}
 		properties.OsProfile = osProfile
 	}
 
@@ -671,11 +745,11 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, vm)
 	if err != nil {
-		return err
+		return fmt.Errorf("Error creating/updating Virtual Machine %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return err
+		return fmt.Errorf("Error waiting for creation/update of Virtual Machine %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name, "")
@@ -706,6 +780,23 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 		"host": ipAddress,
 	})
 
+	if d.HasChange("aad_login_enabled") {
+		enabled := d.Get("aad_login_enabled").(bool)
+		if err := resourceArmVirtualMachineUpdateAADLogin(d, meta, resGroup, name, virtualMachineIsWindows(read.VirtualMachineProperties), enabled); err != nil {
+			return err
+		}
+	}
+
+	// Virtual Machines are running once they've been created, so there's only something to do when that's not desired
+	if v, ok := d.GetOk("power_state"); ok && d.HasChange("power_state") {
+		powerState := v.(string)
+		if !d.IsNewResource() || powerState != virtualMachinePowerStateRunning {
+			if err := resourceArmVirtualMachineSetPowerState(ctx, client, resGroup, name, powerState); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceArmVirtualMachineRead(d, meta)
 }
 
@@ -726,12 +817,21 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Azure Virtual Machine %s: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Azure Virtual Machine %s: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
 	d.Set("zones", resp.Zones)
+
+	// the Instance View can be unavailable whilst the Virtual Machine is transitioning, in which case
+	// the Power State is left as-is rather than failing the whole refresh
+	instanceView, err := vmClient.InstanceView(ctx, resGroup, name)
+	if err != nil {
+		log.Printf("[WARN] Error retrieving Instance View for Virtual Machine %q (Resource Group %q) - skipping `power_state`: %+v", name, resGroup, err)
+	} else {
+		d.Set("power_state", flattenAzureRmVirtualMachinePowerState(instanceView.Statuses))
+	}
 	if location := resp.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
@@ -785,7 +885,15 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		}
 
 		if profile := props.OsProfile; profile != nil {
-			if err := d.Set("os_profile", schema.NewSet(resourceArmVirtualMachineStorageOsProfileHash, flattenAzureRmVirtualMachineOsProfile(profile))); err != nil {
+			// the Key Vault Secret ID isn't returned from the API, so we look it up from the state
+			adminPasswordSecretId := ""
+			if existing := d.Get("os_profile").(*schema.Set).List(); len(existing) > 0 {
+				if v, ok := existing[0].(map[string]interface{}); ok {
+					adminPasswordSecretId = v["admin_password_key_vault_secret_id"].(string)
+				}
+			}
+
+			if err := d.Set("os_profile", schema.NewSet(resourceArmVirtualMachineStorageOsProfileHash, flattenAzureRmVirtualMachineOsProfile(profile, adminPasswordSecretId))); err != nil {
 				return fmt.Errorf("Error setting `os_profile`: %#v", err)
 			}
 
@@ -826,11 +934,116 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	// the extension is only looked up when it's managed through this resource, since it may otherwise be managed
+	// by an `azurerm_virtual_machine_extension` resource instead
+	if d.Get("aad_login_enabled").(bool) {
+		aadLoginEnabled, err := resourceArmVirtualMachineAADLoginEnabled(meta, resGroup, name, virtualMachineIsWindows(resp.VirtualMachineProperties))
+		if err != nil {
+			return err
+		}
+		d.Set("aad_login_enabled", aadLoginEnabled)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
 }
 
+// virtualMachineAADLoginExtension returns the Name, Publisher, Type and Version of the Extension used to
+// allow signing into the Virtual Machine using Azure Active Directory credentials
+func virtualMachineAADLoginExtension(windows bool) (string, string, string, string) {
+	if windows {
+		return "AADLoginForWindows", "Microsoft.Azure.ActiveDirectory", "AADLoginForWindows", "1.0"
+	}
+
+	return "AADLoginForLinux", "Microsoft.Azure.ActiveDirectory.LinuxSSH", "AADLoginForLinux", "1.0"
+}
+
+func virtualMachineIsWindows(props *compute.VirtualMachineProperties) bool {
+	if props == nil {
+		return false
+	}
+
+	if profile := props.OsProfile; profile != nil && profile.WindowsConfiguration != nil {
+		return true
+	}
+
+	if profile := props.StorageProfile; profile != nil && profile.OsDisk != nil {
+		return profile.OsDisk.OsType == compute.Windows
+	}
+
+	return false
+}
+
+func resourceArmVirtualMachineUpdateAADLogin(d *schema.ResourceData, meta interface{}, resGroup string, vmName string, windows bool, enabled bool) error {
+	client := meta.(*ArmClient).vmExtensionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name, publisher, extensionType, version := virtualMachineAADLoginExtension(windows)
+
+	if !enabled {
+		// there's nothing to remove for a new Virtual Machine
+		if d.IsNewResource() {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Removing Extension %q from Virtual Machine %q (Resource Group %q)", name, vmName, resGroup)
+		future, err := client.Delete(ctx, resGroup, vmName, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				return nil
+			}
+			return fmt.Errorf("Error removing Extension %q from Virtual Machine %q (Resource Group %q): %s", name, vmName, resGroup, azure.FormatError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for removal of Extension %q from Virtual Machine %q (Resource Group %q): %s", name, vmName, resGroup, azure.FormatError(err))
+		}
+
+		return nil
+	}
+
+	extension := compute.VirtualMachineExtension{
+		Location: utils.String(azureRMNormalizeLocation(d.Get("location").(string))),
+		VirtualMachineExtensionProperties: &compute.VirtualMachineExtensionProperties{
+			Publisher:               utils.String(publisher),
+			Type:                    utils.String(extensionType),
+			TypeHandlerVersion:      utils.String(version),
+			AutoUpgradeMinorVersion: utils.Bool(true),
+		},
+	}
+
+	log.Printf("[DEBUG] Installing Extension %q on Virtual Machine %q (Resource Group %q)", name, vmName, resGroup)
+	future, err := client.CreateOrUpdate(ctx, resGroup, vmName, name, extension)
+	if err != nil {
+		return fmt.Errorf("Error installing Extension %q on Virtual Machine %q (Resource Group %q): %s", name, vmName, resGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for installation of Extension %q on Virtual Machine %q (Resource Group %q): %s", name, vmName, resGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineAADLoginEnabled(meta interface{}, resGroup string, vmName string, windows bool) (bool, error) {
+	client := meta.(*ArmClient).vmExtensionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name, _, _, _ := virtualMachineAADLoginExtension(windows)
+
+	resp, err := client.Get(ctx, resGroup, vmName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("Error retrieving Extension %q for Virtual Machine %q (Resource Group %q): %s", name, vmName, resGroup, azure.FormatError(err))
+	}
+
+	return true, nil
+}
+
 func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
 	ctx := meta.(*ArmClient).StopContext
@@ -845,6 +1058,14 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 	azureRMLockByName(name, virtualMachineResourceName)
 	defer azureRMUnlockByName(name, virtualMachineResourceName)
 
+	// shutting down the Virtual Machine first allows the OS to run its shutdown scripts, since deletion doesn't
+	if shutdown := d.Get("shutdown_before_deletion").(string); shutdown != "" {
+		log.Printf("[DEBUG] Shutting down Virtual Machine %q (Resource Group %q) prior to deletion", name, resGroup)
+		if err := resourceArmVirtualMachineShutdown(ctx, client, resGroup, name, shutdown); err != nil {
+			return err
+		}
+	}
+
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -854,9 +1075,10 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	// delete OS Disk if opted in
-	if deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool); deleteOsDisk {
-		log.Printf("[INFO] delete_os_disk_on_termination is enabled, deleting disk from %s", name)
+	// delete OS Disk if opted in, either on this resource or via the Provider's `features` block
+	deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool) || meta.(*ArmClient).features.VirtualMachine.DeleteOSDiskOnDeletion
+	if deleteOsDisk {
+		log.Printf("[INFO] Deletion of the OS Disk is enabled, deleting disk from %s", name)
 
 		osDisk, err := expandAzureRmVirtualMachineOsDisk(d)
 		if err != nil {
@@ -880,9 +1102,10 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	// delete Data disks if opted in
-	if deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool); deleteDataDisks {
-		log.Printf("[INFO] delete_data_disks_on_termination is enabled, deleting each data disk from %s", name)
+	// delete Data disks if opted in, either on this resource or via the Provider's `features` block
+	deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool) || meta.(*ArmClient).features.VirtualMachine.DeleteDataDisksOnDeletion
+	if deleteDataDisks {
+		log.Printf("[INFO] Deletion of the Data Disks is enabled, deleting each data disk from %s", name)
 
 		disks, err := expandAzureRmVirtualMachineDataDisk(d)
 		if err != nil {
@@ -904,6 +1127,78 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// delete Network Interfaces if opted in, otherwise they're detached (and retained) when the Virtual Machine is deleted
+	deleteNetworkInterfaces := d.Get("delete_network_interfaces_on_termination").(bool) || meta.(*ArmClient).features.VirtualMachine.DeleteNetworkInterfacesOnDeletion
+	if deleteNetworkInterfaces {
+		log.Printf("[INFO] Deletion of the Network Interfaces is enabled, deleting each network interface from %s", name)
+
+		for _, nicId := range d.Get("network_interface_ids").([]interface{}) {
+			if nicId == nil {
+				continue
+			}
+
+			if err = resourceArmVirtualMachineDeleteNetworkInterface(nicId.(string), meta); err != nil {
+				return fmt.Errorf("Error deleting Network Interface: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineSetPowerState(ctx context.Context, client compute.VirtualMachinesClient, resGroup string, name string, powerState string) error {
+	switch powerState {
+	case virtualMachinePowerStateStopped:
+		return resourceArmVirtualMachineShutdown(ctx, client, resGroup, name, virtualMachineShutdownPowerOff)
+
+	case virtualMachinePowerStateDeallocated:
+		return resourceArmVirtualMachineShutdown(ctx, client, resGroup, name, virtualMachineShutdownDeallocate)
+	}
+
+	log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)", name, resGroup)
+	future, err := client.Start(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to start: %s", name, resGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineShutdown(ctx context.Context, client compute.VirtualMachinesClient, resGroup string, name string, mode string) error {
+	log.Printf("[DEBUG] Shutting down (%s) Virtual Machine %q (Resource Group %q)", mode, name, resGroup)
+
+	if mode == virtualMachineShutdownDeallocate {
+		future, err := client.Deallocate(ctx, resGroup, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				return nil
+			}
+			return fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to be deallocated: %s", name, resGroup, azure.FormatError(err))
+		}
+
+		return nil
+	}
+
+	future, err := client.PowerOff(ctx, resGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error powering off Virtual Machine %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to be powered off: %s", name, resGroup, azure.FormatError(err))
+	}
+
 	return nil
 }
 
@@ -968,16 +1263,67 @@ func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta inter
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting Managed Disk (%s %s) %+v", name, resGroup, err)
+		return fmt.Errorf("Error deleting Managed Disk (%s %s) %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error deleting Managed Disk (%s %s) %+v", name, resGroup, err)
+		return fmt.Errorf("Error deleting Managed Disk (%s %s) %s", name, resGroup, azure.FormatError(err))
 	}
 
 	return nil
 }
 
+func resourceArmVirtualMachineDeleteNetworkInterface(networkInterfaceID string, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(networkInterfaceID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["networkInterfaces"]
+
+	azureRMLockByName(name, networkInterfaceResourceName)
+	defer azureRMUnlockByName(name, networkInterfaceResourceName)
+
+	future, err := client.Delete(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Network Interface %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deletion of Network Interface %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
+// flattenAzureRmVirtualMachinePowerState returns the Power State from the Instance View, treating transitional
+// states (e.g. `starting` or `deallocating`) as the state being transitioned to
+func flattenAzureRmVirtualMachinePowerState(statuses *[]compute.InstanceViewStatus) string {
+	if statuses == nil {
+		return ""
+	}
+
+	for _, status := range *statuses {
+		if status.Code == nil || !strings.HasPrefix(strings.ToLower(*status.Code), "powerstate/") {
+			continue
+		}
+
+		switch strings.TrimPrefix(strings.ToLower(*status.Code), "powerstate/") {
+		case "running", "starting":
+			return virtualMachinePowerStateRunning
+		case "stopped", "stopping":
+			return virtualMachinePowerStateStopped
+		case "deallocated", "deallocating":
+			return virtualMachinePowerStateDeallocated
+		}
+	}
+
+	return ""
+}
+
 func flattenAzureRmVirtualMachinePlan(plan *compute.Plan) []interface{} {
 	if plan == nil {
 		return []interface{}{}
@@ -1143,10 +1489,11 @@ func flattenAzureRmVirtualMachineDataDisk(disks *[]compute.DataDisk, disksInfo [
 	return result
 }
 
-func flattenAzureRmVirtualMachineOsProfile(input *compute.OSProfile) []interface{} {
+func flattenAzureRmVirtualMachineOsProfile(input *compute.OSProfile, adminPasswordSecretId string) []interface{} {
 	result := make(map[string]interface{})
 	result["computer_name"] = *input.ComputerName
 	result["admin_username"] = *input.AdminUsername
+	result["admin_password_key_vault_secret_id"] = adminPasswordSecretId
 	if input.CustomData != nil {
 		result["custom_data"] = *input.CustomData
 	}
@@ -1270,6 +1617,8 @@ func flattenAzureRmVirtualMachineOsDisk(disk *compute.OSDisk, diskInfo *compute.
 		result["write_accelerator_enabled"] = *disk.WriteAcceleratorEnabled
 	}
 
+	result["ephemeral"] = disk.DiffDiskSettings != nil && disk.DiffDiskSettings.Option == compute.Local
+
 	flattenAzureRmVirtualMachineReviseDiskInfo(result, diskInfo)
 
 	return []interface{}{result}
@@ -1739,9 +2088,66 @@ func expandAzureRmVirtualMachineOsDisk(d *schema.ResourceData) (*compute.OSDisk,
 		osDisk.WriteAcceleratorEnabled = utils.Bool(v)
 	}
 
+	if config["ephemeral"].(bool) {
+		if err := validateVirtualMachineEphemeralOsDisk(string(osDisk.Caching), vhdURI == ""); err != nil {
+			return nil, err
+		}
+
+		osDisk.DiffDiskSettings = &compute.DiffDiskSettings{
+			Option: compute.Local,
+		}
+	}
+
 	return osDisk, nil
 }
 
+// validateVirtualMachineEphemeralOsDisk checks the constraints Azure places on Ephemeral OS Disks, which are
+// stored on the local (cache) disk of the host - as such they must be Managed Disks with `ReadOnly` caching
+func validateVirtualMachineEphemeralOsDisk(caching string, managed bool) error {
+	if !managed {
+		return fmt.Errorf("[ERROR] Ephemeral OS Disks must be Managed Disks")
+	}
+
+	if !strings.EqualFold(caching, string(compute.CachingTypesReadOnly)) {
+		return fmt.Errorf("[ERROR] Ephemeral OS Disks require `caching` to be set to `ReadOnly` but got %q", caching)
+	}
+
+	return nil
+}
+
+func azureRmVirtualMachineCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if osProfiles := d.Get("os_profile").(*schema.Set).List(); len(osProfiles) > 0 {
+		if osProfile, ok := osProfiles[0].(map[string]interface{}); ok {
+			if err := validateVirtualMachineOsProfilePassword(osProfile["admin_password"].(string), osProfile["admin_password_key_vault_secret_id"].(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// signing in using Azure Active Directory credentials requires the Virtual Machine has a System Assigned Identity
+	if d.Get("aad_login_enabled").(bool) {
+		identityType := d.Get("identity.0.type").(string)
+		if !strings.Contains(strings.ToLower(identityType), strings.ToLower(string(compute.ResourceIdentityTypeSystemAssigned))) {
+			return fmt.Errorf("`aad_login_enabled` requires an `identity` block with a `type` of `SystemAssigned` or `SystemAssigned, UserAssigned`")
+		}
+	}
+
+	if !d.Get("storage_os_disk.0.ephemeral").(bool) {
+		return nil
+	}
+
+	vhdUri := d.Get("storage_os_disk.0.vhd_uri").(string)
+	return validateVirtualMachineEphemeralOsDisk(d.Get("storage_os_disk.0.caching").(string), vhdUri == "")
+}
+
+func validateVirtualMachineOsProfilePassword(adminPassword string, adminPasswordSecretId string) error {
+	if adminPassword != "" && adminPasswordSecretId != "" {
+		return fmt.Errorf("Only one of `admin_password` and `admin_password_key_vault_secret_id` can be specified within the `os_profile` block")
+	}
+
+	return nil
+}
+
 func findStorageAccountResourceGroup(meta interface{}, storageAccountName string) (string, error) {
 	client := meta.(*ArmClient).resourcesClient
 	ctx := meta.(*ArmClient).StopContext
@@ -1751,7 +2157,7 @@ func findStorageAccountResourceGroup(meta interface{}, storageAccountName string
 
 	rf, err := client.List(ctx, filter, expand, pager)
 	if err != nil {
-		return "", fmt.Errorf("Error making resource request for query %s: %+v", filter, err)
+		return "", fmt.Errorf("Error making resource request for query %s: %s", filter, azure.FormatError(err))
 	}
 
 	results := rf.Values()
@@ -1773,6 +2179,11 @@ func resourceArmVirtualMachineStorageOsProfileHash(v interface{}) int {
 	if m, ok := v.(map[string]interface{}); ok {
 		buf.WriteString(fmt.Sprintf("%s-", m["admin_username"].(string)))
 		buf.WriteString(fmt.Sprintf("%s-", m["computer_name"].(string)))
+
+		// only included when set, so that the hash of existing os_profile blocks is unchanged
+		if v, ok := m["admin_password_key_vault_secret_id"]; ok && v.(string) != "" {
+			buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+		}
 	}
 
 	return hashcode.String(buf.String())
@@ -1845,7 +2256,7 @@ func resourceArmVirtualMachineGetManagedDiskInfo(disk *compute.ManagedDiskParame
 	name := id.Path["disks"]
 	diskResp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return nil, fmt.Errorf("Error retrieving Disk %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	return &diskResp, nil
@@ -1880,7 +2291,7 @@ func determineVirtualMachineIPAddress(ctx context.Context, meta interface{}, pro
 
 				nic, err := nicClient.Get(ctx, resourceGroup, name, "")
 				if err != nil {
-					return "", fmt.Errorf("Error obtaining NIC %q (Resource Group %q): %+v", name, resourceGroup, err)
+					return "", fmt.Errorf("Error obtaining NIC %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 				}
 
 				networkInterface = &nic
@@ -1908,7 +2319,7 @@ func determineVirtualMachineIPAddress(ctx context.Context, meta interface{}, pro
 
 					pip, err := pipClient.Get(ctx, resourceGroup, name, "")
 					if err != nil {
-						return "", fmt.Errorf("Error obtaining Public IP %q (Resource Group %q): %+v", name, resourceGroup, err)
+						return "", fmt.Errorf("Error obtaining Public IP %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 					}
 
 					if pipProps := pip.PublicIPAddressPropertiesFormat; pipProps != nil {
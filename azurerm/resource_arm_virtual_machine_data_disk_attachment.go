@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -32,10 +33,11 @@ func resourceArmVirtualMachineDataDiskAttachment() *schema.Resource {
 			},
 
 			"virtual_machine_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"lun": {
@@ -99,7 +101,7 @@ func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceD
 			return fmt.Errorf("Virtual Machine %q (Resource Group %q) was not found", virtualMachineName, resourceGroup)
 		}
 
-		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %+v", virtualMachineName, resourceGroup, err)
+		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %s", virtualMachineName, resourceGroup, azure.FormatError(err))
 	}
 
 	managedDiskId := d.Get("managed_disk_id").(string)
@@ -167,11 +169,11 @@ func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceD
 	// which we're intentionally not wrapping, since the errors good.
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualMachineName, virtualMachine)
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual Machine %q (Resource Group %q) with Disk %q: %+v", virtualMachineName, resourceGroup, name, err)
+		return fmt.Errorf("Error updating Virtual Machine %q (Resource Group %q) with Disk %q: %s", virtualMachineName, resourceGroup, name, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to finish updating Disk %q: %+v", virtualMachineName, resourceGroup, name, err)
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to finish updating Disk %q: %s", virtualMachineName, resourceGroup, name, azure.FormatError(err))
 	}
 
 	d.SetId(resourceId)
@@ -197,7 +199,7 @@ func resourceArmVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, met
 			return fmt.Errorf("Virtual Machine %q (Resource Group %q) was not found", virtualMachineName, resourceGroup)
 		}
 
-		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %+v", virtualMachineName, resourceGroup, err)
+		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %s", virtualMachineName, resourceGroup, azure.FormatError(err))
 	}
 
 	var disk *compute.DataDisk
@@ -257,7 +259,7 @@ func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, m
 			return fmt.Errorf("Virtual Machine %q (Resource Group %q) was not found", virtualMachineName, resourceGroup)
 		}
 
-		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %+v", virtualMachineName, resourceGroup, err)
+		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %s", virtualMachineName, resourceGroup, azure.FormatError(err))
 	}
 
 	dataDisks := make([]compute.DataDisk, 0)
@@ -275,11 +277,11 @@ func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, m
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualMachineName, virtualMachine)
 	if err != nil {
-		return fmt.Errorf("Error removing Disk %q from Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
+		return fmt.Errorf("Error removing Disk %q from Virtual Machine %q (Resource Group %q): %s", name, virtualMachineName, resourceGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for Disk %q to be removed from Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
+		return fmt.Errorf("Error waiting for Disk %q to be removed from Virtual Machine %q (Resource Group %q): %s", name, virtualMachineName, resourceGroup, azure.FormatError(err))
 	}
 
 	return nil
@@ -302,7 +304,7 @@ func retrieveDataDiskAttachmentManagedDisk(meta interface{}, id string) (*comput
 			return nil, fmt.Errorf("Error Managed Disk %q (Resource Group %q) was not found!", name, resourceGroup)
 		}
 
-		return nil, fmt.Errorf("Error making Read request on Azure Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return nil, fmt.Errorf("Error making Read request on Azure Managed Disk %q (Resource Group %q): %s", name, resourceGroup, azure.FormatError(err))
 	}
 
 	return &resp, nil
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -127,9 +129,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			},
 
 			"health_probe_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"automatic_os_upgrade": {
@@ -189,6 +192,15 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"shutdown_before_deletion": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					virtualMachineShutdownPowerOff,
+					virtualMachineShutdownDeallocate,
+				}, false),
+			},
+
 			"priority": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -250,9 +262,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"source_vault_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"vault_certificates": {
@@ -273,6 +286,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 						},
 					},
 				},
+				Set: resourceArmVirtualMachineScaleSetOsProfileSecretsHash,
 			},
 
 			"os_profile_windows_config": {
@@ -397,9 +411,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 						},
 
 						"network_security_group_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"dns_settings": {
@@ -432,9 +447,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 									},
 
 									"subnet_id": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     azure.ValidateResourceID,
+										DiffSuppressFunc: suppress.ResourceIDDifference,
 									},
 
 									"application_gateway_backend_address_pool_ids": {
@@ -577,6 +593,13 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+
+						"ephemeral": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
 					},
 				},
 				Set: resourceArmVirtualMachineScaleSetStorageProfileOsDiskHash,
@@ -753,7 +776,7 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Virtual Machine Scale Set %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Virtual Machine Scale Set %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -909,7 +932,7 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Azure Virtual Machine Scale Set %s: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Azure Virtual Machine Scale Set %s: %s", name, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -1057,6 +1080,13 @@ func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interf
 	resGroup := id.ResourceGroup
 	name := id.Path["virtualMachineScaleSets"]
 
+	// shutting down the instances first allows the OS to run its shutdown scripts, since deletion doesn't
+	if shutdown := d.Get("shutdown_before_deletion").(string); shutdown != "" {
+		if err := resourceArmVirtualMachineScaleSetShutdown(ctx, client, resGroup, name, shutdown); err != nil {
+			return err
+		}
+	}
+
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -1069,6 +1099,41 @@ func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceArmVirtualMachineScaleSetShutdown(ctx context.Context, client compute.VirtualMachineScaleSetsClient, resGroup string, name string, mode string) error {
+	log.Printf("[DEBUG] Shutting down (%s) the instances of Virtual Machine Scale Set %q (Resource Group %q) prior to deletion", mode, name, resGroup)
+
+	// a nil list of Instance ID's applies the operation to every instance within the Scale Set
+	if mode == virtualMachineShutdownDeallocate {
+		future, err := client.Deallocate(ctx, resGroup, name, nil)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				return nil
+			}
+			return fmt.Errorf("Error deallocating Virtual Machine Scale Set %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Virtual Machine Scale Set %q (Resource Group %q) to be deallocated: %s", name, resGroup, azure.FormatError(err))
+		}
+
+		return nil
+	}
+
+	future, err := client.PowerOff(ctx, resGroup, name, nil)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error powering off Virtual Machine Scale Set %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine Scale Set %q (Resource Group %q) to be powered off: %s", name, resGroup, azure.FormatError(err))
+	}
+
+	return nil
+}
+
 func flattenAzureRmVirtualMachineScaleSetIdentity(identity *compute.VirtualMachineScaleSetIdentity) []interface{} {
 	if identity == nil {
 		return make([]interface{}, 0)
@@ -1389,6 +1454,7 @@ func flattenAzureRmVirtualMachineScaleSetStorageProfileOSDisk(profile *compute.V
 	result["caching"] = profile.Caching
 	result["create_option"] = profile.CreateOption
 	result["os_type"] = profile.OsType
+	result["ephemeral"] = profile.DiffDiskSettings != nil && profile.DiffDiskSettings.Option == compute.Local
 
 	return []interface{}{result}
 }
@@ -1518,6 +1584,9 @@ func resourceArmVirtualMachineScaleSetStorageProfileOsDiskHash(v interface{}) in
 	return hashcode.String(buf.String())
 }
 
+// resourceArmVirtualMachineScaleSetNetworkConfigurationHash intentionally doesn't include the nested Resource IDs
+// (e.g. `network_security_group_id` and `subnet_id`) so that casing differences in them are suppressed rather than
+// producing a new element in the set
 func resourceArmVirtualMachineScaleSetNetworkConfigurationHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -1914,6 +1983,16 @@ func expandAzureRMVirtualMachineScaleSetsStorageProfileOsDisk(d *schema.Resource
 	}
 	//END: code to be removed after GH-13016 is merged
 
+	if osDiskConfig["ephemeral"].(bool) {
+		if err := validateVirtualMachineEphemeralOsDisk(caching, managedDiskType != ""); err != nil {
+			return nil, err
+		}
+
+		osDisk.DiffDiskSettings = &compute.DiffDiskSettings{
+			Option: compute.Local,
+		}
+	}
+
 	return osDisk, nil
 }
 
@@ -2209,7 +2288,8 @@ func azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff(k, _, new str
 	return false
 }
 
-// Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling.
+// Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling,
+// and that an Ephemeral OS Disk is configured in a way Azure supports.
 func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
 	mode := d.Get("upgrade_policy_mode").(string)
 	if strings.ToLower(mode) != "rolling" {
@@ -2224,5 +2304,47 @@ func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interf
 			}
 		}
 	}
+
+	for _, raw := range d.Get("storage_profile_os_disk").(*schema.Set).List() {
+		osDisk := raw.(map[string]interface{})
+		if !osDisk["ephemeral"].(bool) {
+			continue
+		}
+
+		if err := validateVirtualMachineEphemeralOsDisk(osDisk["caching"].(string), osDisk["managed_disk_type"].(string) != ""); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+func resourceArmVirtualMachineScaleSetOsProfileSecretsHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		// Resource IDs are case-insensitive, and Azure doesn't always return them with the same casing
+		buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["source_vault_id"].(string))))
+
+		certificates := make([]map[string]interface{}, 0)
+		switch raw := m["vault_certificates"].(type) {
+		case []interface{}:
+			for _, v := range raw {
+				if certificate, ok := v.(map[string]interface{}); ok {
+					certificates = append(certificates, certificate)
+				}
+			}
+		case []map[string]interface{}:
+			certificates = raw
+		}
+
+		for _, certificate := range certificates {
+			buf.WriteString(fmt.Sprintf("%s-", certificate["certificate_url"]))
+			if store, ok := certificate["certificate_store"]; ok && store != nil {
+				buf.WriteString(fmt.Sprintf("%s-", store))
+			}
+		}
+	}
+
+	return hashcode.String(buf.String())
+}
@@ -16,6 +16,44 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
+func TestResourceArmVirtualMachineScaleSetOsProfileSecretsHash(t *testing.T) {
+	configured := map[string]interface{}{
+		"source_vault_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+		"vault_certificates": []interface{}{
+			map[string]interface{}{
+				"certificate_url":   "https://vault1.vault.azure.net/secrets/cert1/version1",
+				"certificate_store": "My",
+			},
+		},
+	}
+	returned := map[string]interface{}{
+		"source_vault_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.keyvault/vaults/vault1",
+		"vault_certificates": []map[string]interface{}{
+			{
+				"certificate_url":   "https://vault1.vault.azure.net/secrets/cert1/version1",
+				"certificate_store": "My",
+			},
+		},
+	}
+	updated := map[string]interface{}{
+		"source_vault_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+		"vault_certificates": []interface{}{
+			map[string]interface{}{
+				"certificate_url":   "https://vault1.vault.azure.net/secrets/cert1/version2",
+				"certificate_store": "My",
+			},
+		},
+	}
+
+	if resourceArmVirtualMachineScaleSetOsProfileSecretsHash(configured) != resourceArmVirtualMachineScaleSetOsProfileSecretsHash(returned) {
+		t.Fatalf("Expected the hash to ignore the casing of `source_vault_id`")
+	}
+
+	if resourceArmVirtualMachineScaleSetOsProfileSecretsHash(configured) == resourceArmVirtualMachineScaleSetOsProfileSecretsHash(updated) {
+		t.Fatalf("Expected the hash to change when a certificate changes")
+	}
+}
+
 func TestAccAzureRMVirtualMachineScaleSet_basic(t *testing.T) {
 	resourceName := "azurerm_virtual_machine_scale_set.test"
 	ri := tf.AccRandTimeInt()
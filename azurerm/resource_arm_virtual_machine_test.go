@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
@@ -12,8 +13,60 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_virtual_machine", &resource.Sweeper{
+		Name: "azurerm_virtual_machine",
+		F:    testSweepVirtualMachines,
+	})
+}
+
+func testSweepVirtualMachines(region string) error {
+	armClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := armClient.vmClient
+	ctx := armClient.StopContext
+
+	log.Printf("[DEBUG] Listing Virtual Machines to sweep in %q", region)
+	machines, err := client.ListAllComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error listing Virtual Machines: %+v", err)
+	}
+
+	for machines.NotDone() {
+		vm := machines.Value()
+		if vm.ID != nil && vm.Name != nil && vm.Location != nil && shouldSweepAcceptanceTestResource(*vm.Name, *vm.Location, region) {
+			id, err := parseAzureResourceID(*vm.ID)
+			if err != nil {
+				return err
+			}
+
+			name := *vm.Name
+			log.Printf("[DEBUG] Deleting Virtual Machine %q (Resource Group %q)", name, id.ResourceGroup)
+
+			future, err := client.Delete(ctx, id.ResourceGroup, name)
+			if err != nil {
+				return fmt.Errorf("Error deleting Virtual Machine %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for deletion of Virtual Machine %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+			}
+		}
+
+		if err := machines.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Virtual Machines: %+v", err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccAzureRMVirtualMachine_winTimeZone(t *testing.T) {
 	resourceName := "azurerm_virtual_machine.test"
 	var vm compute.VirtualMachine
@@ -106,6 +159,149 @@ func TestAccAzureRMVirtualMachine_multipleAssignedIdentity(t *testing.T) {
 	})
 }
 
+func TestAzureRMVirtualMachinePowerState(t *testing.T) {
+	testData := []struct {
+		Codes    []string
+		Expected string
+	}{
+		{
+			Codes:    []string{},
+			Expected: "",
+		},
+		{
+			Codes:    []string{"ProvisioningState/succeeded", "PowerState/running"},
+			Expected: "running",
+		},
+		{
+			Codes:    []string{"ProvisioningState/updating", "PowerState/stopping"},
+			Expected: "stopped",
+		},
+		{
+			Codes:    []string{"ProvisioningState/succeeded", "PowerState/deallocated"},
+			Expected: "deallocated",
+		},
+		{
+			Codes:    []string{"PowerState/Deallocating"},
+			Expected: "deallocated",
+		},
+	}
+
+	for _, v := range testData {
+		statuses := make([]compute.InstanceViewStatus, 0)
+		for _, code := range v.Codes {
+			statuses = append(statuses, compute.InstanceViewStatus{
+				Code: utils.String(code),
+			})
+		}
+
+		actual := flattenAzureRmVirtualMachinePowerState(&statuses)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q for %+v but got %q", v.Expected, v.Codes, actual)
+		}
+	}
+}
+
+func TestResourceArmVirtualMachineStorageOsProfileHash(t *testing.T) {
+	base := map[string]interface{}{
+		"admin_username": "testadmin",
+		"computer_name":  "hostname",
+	}
+	withEmptySecret := map[string]interface{}{
+		"admin_username":                     "testadmin",
+		"computer_name":                      "hostname",
+		"admin_password_key_vault_secret_id": "",
+	}
+	withSecret := map[string]interface{}{
+		"admin_username":                     "testadmin",
+		"computer_name":                      "hostname",
+		"admin_password_key_vault_secret_id": "https://example.vault.azure.net/secrets/password/abc123",
+	}
+
+	if resourceArmVirtualMachineStorageOsProfileHash(base) != resourceArmVirtualMachineStorageOsProfileHash(withEmptySecret) {
+		t.Fatalf("Expected an empty `admin_password_key_vault_secret_id` not to change the hash")
+	}
+
+	if resourceArmVirtualMachineStorageOsProfileHash(base) == resourceArmVirtualMachineStorageOsProfileHash(withSecret) {
+		t.Fatalf("Expected `admin_password_key_vault_secret_id` to be included in the hash")
+	}
+}
+
+func TestValidateVirtualMachineOsProfilePassword(t *testing.T) {
+	testData := []struct {
+		Password string
+		SecretId string
+		Error    bool
+	}{
+		{
+			Password: "",
+			SecretId: "",
+			Error:    false,
+		},
+		{
+			Password: "Password1234!",
+			SecretId: "",
+			Error:    false,
+		},
+		{
+			Password: "",
+			SecretId: "https://example.vault.azure.net/secrets/password/abc123",
+			Error:    false,
+		},
+		{
+			Password: "Password1234!",
+			SecretId: "https://example.vault.azure.net/secrets/password/abc123",
+			Error:    true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.Password, v.SecretId)
+
+		err := validateVirtualMachineOsProfilePassword(v.Password, v.SecretId)
+		if v.Error && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestAccAzureRMVirtualMachine_powerState(t *testing.T) {
+	resourceName := "azurerm_virtual_machine.test"
+	var vm compute.VirtualMachine
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachine_powerState(ri, location, "running"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists(resourceName, &vm),
+					resource.TestCheckResourceAttr(resourceName, "power_state", "running"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachine_powerState(ri, location, "deallocated"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists(resourceName, &vm),
+					resource.TestCheckResourceAttr(resourceName, "power_state", "deallocated"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachine_powerState(ri, location, "running"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists(resourceName, &vm),
+					resource.TestCheckResourceAttr(resourceName, "power_state", "running"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMVirtualMachineExists(resourceName string, vm *compute.VirtualMachine) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -550,3 +746,77 @@ resource "azurerm_virtual_machine" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, rInt, rString, rInt, rInt)
 }
+
+func testAccAzureRMVirtualMachine_powerState(rInt int, location string, powerState string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_D1_v2"
+  power_state           = "%s"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    disk_size_gb      = "50"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+
+  tags = {
+    environment = "Production"
+    cost-center = "Ops"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, powerState, rInt, rInt)
+}
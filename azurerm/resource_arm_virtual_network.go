@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -13,6 +15,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -29,6 +32,8 @@ func resourceArmVirtualNetwork() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmVirtualNetworkCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -47,7 +52,7 @@ func resourceArmVirtualNetwork() *schema.Resource {
 				MinItems: 1,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validate.NoEmptyStrings,
+					ValidateFunc: validate.CIDRNetworkAddress,
 				},
 			},
 
@@ -58,9 +63,10 @@ func resourceArmVirtualNetwork() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 						"enable": {
 							Type:     schema.TypeBool,
@@ -93,7 +99,7 @@ func resourceArmVirtualNetwork() *schema.Resource {
 						"address_prefix": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validate.NoEmptyStrings,
+							ValidateFunc: validate.CIDRNetworkAddress,
 						},
 						"security_group": {
 							Type:     schema.TypeString,
@@ -126,7 +132,7 @@ func resourceArmVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interfac
 		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Virtual Network %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Virtual Network %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 			}
 		}
 
@@ -168,11 +174,11 @@ func resourceArmVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interfac
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, vnet)
 	if err != nil {
-		return fmt.Errorf("Error Creating/Updating Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error Creating/Updating Virtual Network %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error waiting for completion of Virtual Network %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name, "")
@@ -205,7 +211,7 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error making Read request on Virtual Network %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -261,11 +267,11 @@ func resourceArmVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) e
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error deleting Virtual Network %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for deletion of Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error waiting for deletion of Virtual Network %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
 	}
 
 	return nil
@@ -469,3 +475,83 @@ func expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupNames(d *schema.Resou
 
 	return nsgNames, nil
 }
+
+func resourceArmVirtualNetworkCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// the address space and subnets may be interpolated from other resources, in which case they can't be checked
+	if !diff.NewValueKnown("address_space") || !diff.NewValueKnown("subnet") {
+		return nil
+	}
+
+	addressSpace := make([]string, 0)
+	for _, v := range diff.Get("address_space").([]interface{}) {
+		addressSpace = append(addressSpace, v.(string))
+	}
+
+	subnets := make(map[string]string)
+	for _, raw := range diff.Get("subnet").(*schema.Set).List() {
+		subnet := raw.(map[string]interface{})
+		subnets[subnet["name"].(string)] = subnet["address_prefix"].(string)
+	}
+
+	return validateVirtualNetworkAddressPrefixes(addressSpace, subnets)
+}
+
+// validateVirtualNetworkAddressPrefixes ensures the CIDR blocks within the address space don't overlap, and that each
+// subnet is contained within the address space and doesn't overlap another subnet
+func validateVirtualNetworkAddressPrefixes(addressSpace []string, subnets map[string]string) error {
+	for i, first := range addressSpace {
+		for _, second := range addressSpace[i+1:] {
+			overlap, err := validate.CIDRsOverlap(first, second)
+			if err != nil {
+				// invalid CIDR blocks are reported by the schema validation
+				continue
+			}
+
+			if overlap {
+				return fmt.Errorf("the address spaces %q and %q in `address_space` overlap", first, second)
+			}
+		}
+	}
+
+	names := make([]string, 0)
+	for name := range subnets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		prefix := subnets[name]
+
+		withinAddressSpace := false
+		comparable := true
+		for _, space := range addressSpace {
+			within, err := validate.CIDRIsWithinCIDR(prefix, space)
+			if err != nil {
+				comparable = false
+				continue
+			}
+
+			if within {
+				withinAddressSpace = true
+				break
+			}
+		}
+
+		if comparable && !withinAddressSpace {
+			return fmt.Errorf("the `address_prefix` %q of subnet %q is not within the `address_space` %q", prefix, name, strings.Join(addressSpace, ", "))
+		}
+
+		for _, otherName := range names[i+1:] {
+			overlap, err := validate.CIDRsOverlap(prefix, subnets[otherName])
+			if err != nil {
+				continue
+			}
+
+			if overlap {
+				return fmt.Errorf("the `address_prefix` %q of subnet %q overlaps with the `address_prefix` %q of subnet %q", prefix, name, subnets[otherName], otherName)
+			}
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,78 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
+func TestValidateVirtualNetworkAddressPrefixes(t *testing.T) {
+	cases := []struct {
+		Name         string
+		AddressSpace []string
+		Subnets      map[string]string
+		ExpectError  bool
+	}{
+		{
+			Name:         "Valid",
+			AddressSpace: []string{"10.0.0.0/16", "10.1.0.0/16"},
+			Subnets: map[string]string{
+				"first":  "10.0.1.0/24",
+				"second": "10.1.1.0/24",
+			},
+			ExpectError: false,
+		},
+		{
+			Name:         "Overlapping Address Space",
+			AddressSpace: []string{"10.0.0.0/16", "10.0.1.0/24"},
+			Subnets:      map[string]string{},
+			ExpectError:  true,
+		},
+		{
+			Name:         "Subnet Outside of Address Space",
+			AddressSpace: []string{"10.0.0.0/16"},
+			Subnets: map[string]string{
+				"first": "10.2.1.0/24",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "Overlapping Subnets",
+			AddressSpace: []string{"10.0.0.0/16"},
+			Subnets: map[string]string{
+				"first":  "10.0.0.0/23",
+				"second": "10.0.1.0/24",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "Empty Subnet Prefix and Subnet Outside of Address Space",
+			AddressSpace: []string{"10.0.0.0/16"},
+			Subnets: map[string]string{
+				"first":  "",
+				"second": "10.2.1.0/24",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "Invalid Address Space and Overlapping Subnets",
+			AddressSpace: []string{"10.0.0.0/16", "invalid"},
+			Subnets: map[string]string{
+				"first":  "10.0.0.0/23",
+				"second": "10.0.1.0/24",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateVirtualNetworkAddressPrefixes(v.AddressSpace, v.Subnets)
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
 func TestAccAzureRMVirtualNetwork_basic(t *testing.T) {
 	resourceName := "azurerm_virtual_network.test"
 	ri := tf.AccRandTimeInt()
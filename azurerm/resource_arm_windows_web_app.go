@@ -0,0 +1,396 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmWindowsWebApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWindowsWebAppCreate,
+		Read:   resourceArmWindowsWebAppRead,
+		Update: resourceArmWindowsWebAppUpdate,
+		Delete: resourceArmWindowsWebAppDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: azure.WindowsWebAppCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppServiceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"service_plan_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"site_config": azure.SchemaWindowsWebAppSiteConfig(),
+
+			"app_settings": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			"client_affinity_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"client_cert_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"connection_string": schemaWebAppConnectionString(),
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"https_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"identity": schemaWebAppIdentity(),
+
+			"tags": tagsSchema(),
+
+			"default_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outbound_ip_addresses": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"possible_outbound_ip_addresses": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"site_credential": schemaWebAppSiteCredential(),
+		},
+	}
+}
+
+func resourceArmWindowsWebAppCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM Windows Web App creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_windows_web_app", *existing.ID)
+		}
+	}
+
+	availabilityRequest := web.ResourceNameAvailabilityRequest{
+		Name: utils.String(name),
+		Type: web.CheckNameResourceTypesMicrosoftWebsites,
+	}
+	available, err := client.CheckNameAvailability(ctx, availabilityRequest)
+	if err != nil {
+		return fmt.Errorf("Error checking if the name %q was available: %s", name, azure.FormatError(err))
+	}
+
+	if !*available.NameAvailable {
+		return fmt.Errorf("The name %q used for the Windows Web App needs to be globally unique and isn't available: %s", name, *available.Message)
+	}
+
+	siteConfig := azure.ExpandWindowsWebAppSiteConfig(d.Get("site_config").([]interface{}))
+
+	siteConfig.AppSettings = expandWebAppAppSettingsForCreate(d)
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	siteEnvelope := web.Site{
+		Location: utils.String(location),
+		Tags:     expandTags(tags),
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID: utils.String(d.Get("service_plan_id").(string)),
+			Enabled:      utils.Bool(d.Get("enabled").(bool)),
+			HTTPSOnly:    utils.Bool(d.Get("https_only").(bool)),
+			SiteConfig:   siteConfig,
+		},
+	}
+
+	if _, ok := d.GetOk("identity"); ok {
+		siteEnvelope.Identity = expandAzureRmAppServiceIdentity(d)
+	}
+
+	if v, ok := d.GetOkExists("client_affinity_enabled"); ok {
+		siteEnvelope.SiteProperties.ClientAffinityEnabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("client_cert_enabled"); ok {
+		siteEnvelope.SiteProperties.ClientCertEnabled = utils.Bool(v.(bool))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, name, siteEnvelope)
+	if err != nil {
+		return fmt.Errorf("Error creating Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	read, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Windows Web App %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	if _, ok := d.GetOk("connection_string"); ok {
+		properties := web.ConnectionStringDictionary{
+			Properties: expandAppServiceConnectionStrings(d),
+		}
+
+		if _, err := client.UpdateConnectionStrings(ctx, resGroup, name, properties); err != nil {
+			return fmt.Errorf("Error updating Connection Strings for Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+		}
+	}
+
+	return resourceArmWindowsWebAppRead(d, meta)
+}
+
+func resourceArmWindowsWebAppUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	siteConfig := azure.ExpandWindowsWebAppSiteConfig(d.Get("site_config").([]interface{}))
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	siteEnvelope := web.Site{
+		Location: utils.String(location),
+		Tags:     expandTags(tags),
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID:          utils.String(d.Get("service_plan_id").(string)),
+			Enabled:               utils.Bool(d.Get("enabled").(bool)),
+			HTTPSOnly:             utils.Bool(d.Get("https_only").(bool)),
+			ClientAffinityEnabled: utils.Bool(d.Get("client_affinity_enabled").(bool)),
+			ClientCertEnabled:     utils.Bool(d.Get("client_cert_enabled").(bool)),
+			SiteConfig:            siteConfig,
+		},
+	}
+
+	if _, ok := d.GetOk("identity"); ok {
+		siteEnvelope.Identity = expandAzureRmAppServiceIdentity(d)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, name, siteEnvelope)
+	if err != nil {
+		return fmt.Errorf("Error updating Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	if d.HasChange("site_config") {
+		siteConfigResource := web.SiteConfigResource{
+			SiteConfig: siteConfig,
+		}
+
+		if _, err := client.CreateOrUpdateConfiguration(ctx, resGroup, name, siteConfigResource); err != nil {
+			return fmt.Errorf("Error updating Configuration for Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+		}
+	}
+
+	if d.HasChange("app_settings") {
+		settings := web.StringDictionary{
+			Properties: expandAppServiceAppSettings(d),
+		}
+
+		if _, err := client.UpdateApplicationSettings(ctx, resGroup, name, settings); err != nil {
+			return fmt.Errorf("Error updating Application Settings for Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+		}
+	}
+
+	if d.HasChange("connection_string") {
+		properties := web.ConnectionStringDictionary{
+			Properties: expandAppServiceConnectionStrings(d),
+		}
+
+		if _, err := client.UpdateConnectionStrings(ctx, resGroup, name, properties); err != nil {
+			return fmt.Errorf("Error updating Connection Strings for Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+		}
+	}
+
+	return resourceArmWindowsWebAppRead(d, meta)
+}
+
+func resourceArmWindowsWebAppRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Windows Web App %q (Resource Group %q) was not found - removing from state", name, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	if resp.Kind != nil && strings.Contains(strings.ToLower(*resp.Kind), "linux") {
+		return fmt.Errorf("Error: App Service %q (Resource Group %q) is not a Windows Web App (Kind %q) - use the `azurerm_linux_web_app` or `azurerm_app_service` resources instead", name, resGroup, *resp.Kind)
+	}
+
+	configResp, err := client.GetConfiguration(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Configuration for Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	appSettingsResp, err := client.ListApplicationSettings(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Settings for Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	connectionStringsResp, err := client.ListConnectionStrings(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection Strings for Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	siteCredFuture, err := client.ListPublishingCredentials(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Site Credentials for Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+	if err = siteCredFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Site Credentials for Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+	siteCredResp, err := siteCredFuture.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Site Credentials for Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.SiteProperties; props != nil {
+		d.Set("service_plan_id", props.ServerFarmID)
+		d.Set("client_affinity_enabled", props.ClientAffinityEnabled)
+		d.Set("client_cert_enabled", props.ClientCertEnabled)
+		d.Set("enabled", props.Enabled)
+		d.Set("https_only", props.HTTPSOnly)
+		d.Set("default_hostname", props.DefaultHostName)
+		d.Set("outbound_ip_addresses", props.OutboundIPAddresses)
+		d.Set("possible_outbound_ip_addresses", props.PossibleOutboundIPAddresses)
+	}
+
+	if err := d.Set("app_settings", flattenAppServiceAppSettings(appSettingsResp.Properties)); err != nil {
+		return fmt.Errorf("Error setting `app_settings`: %s", err)
+	}
+
+	if err := d.Set("connection_string", flattenAppServiceConnectionStrings(connectionStringsResp.Properties)); err != nil {
+		return fmt.Errorf("Error setting `connection_string`: %s", err)
+	}
+
+	if err := d.Set("identity", flattenAzureRmAppServiceMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %s", err)
+	}
+
+	if err := d.Set("site_config", azure.FlattenWindowsWebAppSiteConfig(configResp.SiteConfig)); err != nil {
+		return fmt.Errorf("Error setting `site_config`: %s", err)
+	}
+
+	if err := d.Set("site_credential", flattenAppServiceSiteCredential(siteCredResp.UserProperties)); err != nil {
+		return fmt.Errorf("Error setting `site_credential`: %s", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmWindowsWebAppDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	log.Printf("[DEBUG] Deleting Windows Web App %q (Resource Group %q)", name, resGroup)
+
+	deleteMetrics := true
+	deleteEmptyServerFarm := false
+	resp, err := client.Delete(ctx, resGroup, name, &deleteMetrics, &deleteEmptyServerFarm)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Windows Web App %q (Resource Group %q): %s", name, resGroup, azure.FormatError(err))
+		}
+	}
+
+	return nil
+}
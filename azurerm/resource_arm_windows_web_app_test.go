@@ -0,0 +1,265 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMWindowsWebApp_basic(t *testing.T) {
+	resourceName := "azurerm_windows_web_app.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMWindowsWebApp_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsWebAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsWebAppExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "default_hostname"),
+					resource.TestCheckResourceAttrSet(resourceName, "outbound_ip_addresses"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsWebApp_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_windows_web_app.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsWebAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsWebApp_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsWebAppExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMWindowsWebApp_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_windows_web_app"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsWebApp_applicationStack(t *testing.T) {
+	resourceName := "azurerm_windows_web_app.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsWebAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsWebApp_dotNet(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsWebAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.application_stack.0.dotnet_version", "v4.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMWindowsWebApp_java(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsWebAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.application_stack.0.java_version", "1.8"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.application_stack.0.java_container", "TOMCAT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsWebApp_autoHeal(t *testing.T) {
+	resourceName := "azurerm_windows_web_app.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMWindowsWebApp_autoHeal(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsWebAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsWebAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal_rules.0.action.0.action_type", "CustomAction"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal_rules.0.trigger.0.slow_request.0.time_taken", "00:00:30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMWindowsWebAppDestroy(s *terraform.State) error {
+	return testCheckAzureRMWebAppDestroy(s, "azurerm_windows_web_app")
+}
+
+func testCheckAzureRMWindowsWebAppExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMWebAppExists(resourceName)
+}
+
+func testAccAzureRMWindowsWebApp_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMWindowsWebApp_basic(rInt int, location string) string {
+	template := testAccAzureRMWindowsWebApp_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  service_plan_id     = "${azurerm_app_service_plan.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMWindowsWebApp_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMWindowsWebApp_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_web_app" "import" {
+  name                = "${azurerm_windows_web_app.test.name}"
+  location            = "${azurerm_windows_web_app.test.location}"
+  resource_group_name = "${azurerm_windows_web_app.test.resource_group_name}"
+  service_plan_id     = "${azurerm_windows_web_app.test.service_plan_id}"
+}
+`, template)
+}
+
+func testAccAzureRMWindowsWebApp_dotNet(rInt int, location string) string {
+	template := testAccAzureRMWindowsWebApp_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  service_plan_id     = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    application_stack {
+      dotnet_version = "v4.0"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMWindowsWebApp_java(rInt int, location string) string {
+	template := testAccAzureRMWindowsWebApp_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  service_plan_id     = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    application_stack {
+      java_version           = "1.8"
+      java_container         = "TOMCAT"
+      java_container_version = "9.0"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMWindowsWebApp_autoHeal(rInt int, location string) string {
+	template := testAccAzureRMWindowsWebApp_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  service_plan_id     = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    auto_heal_enabled = true
+
+    auto_heal_rules {
+      action {
+        action_type = "CustomAction"
+
+        custom_action {
+          executable = "D:\\home\\site\\wwwroot\\heal.cmd"
+          parameters = "-verbose"
+        }
+      }
+
+      trigger {
+        private_memory_kb = 204800
+
+        slow_request {
+          count      = 10
+          interval   = "00:05:00"
+          time_taken = "00:00:30"
+        }
+      }
+    }
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+// sensitiveValue returns the value which should be stored in the state for a sensitive attribute. When the Provider
+// is configured with `hash_sensitive_values` this is a SHA256 hash of the value (so changes can still be detected)
+// - otherwise the value is returned as-is. Data Sources don't use this, so the plaintext value can be retrieved on demand.
+func sensitiveValue(meta interface{}, value *string) *string {
+	if value == nil || *value == "" {
+		return value
+	}
+
+	if client, ok := meta.(*ArmClient); ok && client.hashSensitiveValues {
+		hashed := hashSensitiveValue(*value)
+		return &hashed
+	}
+
+	return value
+}
+
+// sensitiveValuesInList applies sensitiveValue to the specified keys of each (flattened) block in the list
+func sensitiveValuesInList(meta interface{}, input []interface{}, keys ...string) []interface{} {
+	for _, raw := range input {
+		block, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, key := range keys {
+			if v, ok := block[key].(string); ok {
+				block[key] = *sensitiveValue(meta, &v)
+			}
+		}
+	}
+
+	return input
+}
+
+func hashSensitiveValue(value string) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(value)))
+}
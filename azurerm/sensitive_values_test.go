@@ -0,0 +1,89 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestSensitiveValue(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Hash     bool
+		Input    *string
+		Expected *string
+	}{
+		{
+			Name:     "nil",
+			Hash:     true,
+			Input:    nil,
+			Expected: nil,
+		},
+		{
+			Name:     "empty",
+			Hash:     true,
+			Input:    utils.String(""),
+			Expected: utils.String(""),
+		},
+		{
+			Name:     "disabled",
+			Hash:     false,
+			Input:    utils.String("hello"),
+			Expected: utils.String("hello"),
+		},
+		{
+			Name:     "enabled",
+			Hash:     true,
+			Input:    utils.String("hello"),
+			Expected: utils.String("sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			meta := &ArmClient{
+				hashSensitiveValues: tc.Hash,
+			}
+
+			actual := sensitiveValue(meta, tc.Input)
+			if tc.Expected == nil {
+				if actual != nil {
+					t.Fatalf("Expected nil but got %q", *actual)
+				}
+				return
+			}
+
+			if actual == nil || *actual != *tc.Expected {
+				t.Fatalf("Expected %q but got %v", *tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestSensitiveValuesInList(t *testing.T) {
+	meta := &ArmClient{
+		hashSensitiveValues: true,
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"host":     "https://example.com",
+			"password": "hello",
+		},
+	}
+
+	output := sensitiveValuesInList(meta, input, "password", "client_key")
+	block := output[0].(map[string]interface{})
+
+	if block["host"] != "https://example.com" {
+		t.Fatalf("Expected `host` to be unchanged but got %q", block["host"])
+	}
+
+	if expected := "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"; block["password"] != expected {
+		t.Fatalf("Expected `password` to be %q but got %q", expected, block["password"])
+	}
+
+	if _, ok := block["client_key"]; ok {
+		t.Fatalf("Expected `client_key` not to be added")
+	}
+}
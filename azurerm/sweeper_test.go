@@ -0,0 +1,123 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// acceptanceTestResourcePrefixes are the name prefixes used for resources created by the Acceptance Tests - only
+// resources starting with one of these are removed by the Sweepers
+var acceptanceTestResourcePrefixes = []string{
+	"acctest",
+	"acctvm-",
+}
+
+// TestMain allows the Sweepers to be run via `go test ./azurerm -v -sweep=westeurope,eastus`
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sharedClientForRegion returns an ArmClient configured using the same Environment Variables as the Acceptance Tests
+func sharedClientForRegion(region string) (*ArmClient, error) {
+	variables := []string{
+		"ARM_CLIENT_ID",
+		"ARM_CLIENT_SECRET",
+		"ARM_SUBSCRIPTION_ID",
+		"ARM_TENANT_ID",
+	}
+	for _, variable := range variables {
+		if os.Getenv(variable) == "" {
+			return nil, fmt.Errorf("`%s` must be set to run the Sweepers", variable)
+		}
+	}
+
+	builder := authentication.Builder{
+		SubscriptionID: os.Getenv("ARM_SUBSCRIPTION_ID"),
+		ClientID:       os.Getenv("ARM_CLIENT_ID"),
+		TenantID:       os.Getenv("ARM_TENANT_ID"),
+		ClientSecret:   os.Getenv("ARM_CLIENT_SECRET"),
+		Environment:    testArmEnvironmentName(),
+
+		SupportsClientSecretAuth: true,
+	}
+	config, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("Error building ARM Client for the Sweepers in %q: %+v", region, err)
+	}
+
+	client, err := getArmClient(config, true, "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	client.StopContext = context.Background()
+	return client, nil
+}
+
+// shouldSweepAcceptanceTestResource returns whether the named resource was created by the Acceptance Tests
+// and exists in the region being swept
+func shouldSweepAcceptanceTestResource(name string, resourceLocation string, region string) bool {
+	if azureRMNormalizeLocation(resourceLocation) != azureRMNormalizeLocation(region) {
+		log.Printf("[DEBUG] Not sweeping %q since it's in %q rather than %q", name, resourceLocation, region)
+		return false
+	}
+
+	for _, prefix := range acceptanceTestResourcePrefixes {
+		if strings.HasPrefix(strings.ToLower(name), prefix) {
+			return true
+		}
+	}
+
+	log.Printf("[DEBUG] Not sweeping %q since it wasn't created by the Acceptance Tests", name)
+	return false
+}
+
+func TestShouldSweepAcceptanceTestResource(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Location string
+		Region   string
+		Expected bool
+	}{
+		{
+			Name:     "acctestRG-1234",
+			Location: "westeurope",
+			Region:   "West Europe",
+			Expected: true,
+		},
+		{
+			Name:     "acctvm-1234",
+			Location: "westeurope",
+			Region:   "westeurope",
+			Expected: true,
+		},
+		{
+			Name:     "acctestRG-1234",
+			Location: "eastus",
+			Region:   "westeurope",
+			Expected: false,
+		},
+		{
+			Name:     "production",
+			Location: "westeurope",
+			Region:   "westeurope",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q in %q (Region %q)..", v.Name, v.Location, v.Region)
+
+		actual := shouldSweepAcceptanceTestResource(v.Name, v.Location, v.Region)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/satori/uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 func validateRFC3339Date(v interface{}, k string) (warnings []string, errors []error) {
@@ -205,3 +206,65 @@ func validateFilePath() schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+// validateResourceIDsInProviderSubscription returns a CustomizeDiffFunc which ensures the Resource IDs in the
+// specified fields belong to the Subscription the Provider is configured for, since these are looked up using
+// the Provider's clients and would otherwise fail at apply time with a 404. Data Sources which only read the
+// referenced resource shouldn't use this, so that cross-subscription lookups remain possible there.
+func validateResourceIDsInProviderSubscription(fields ...string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		subscriptionId := meta.(*ArmClient).subscriptionId
+
+		for _, field := range fields {
+			if !d.NewValueKnown(field) {
+				continue
+			}
+
+			id := d.Get(field).(string)
+			if id == "" {
+				continue
+			}
+
+			if err := azure.ValidateResourceIDInSubscription(id, subscriptionId); err != nil {
+				return fmt.Errorf("`%s` is invalid: %+v", field, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// validateDatabaseServerCreateMode is used within the CustomizeDiff of MySQL and PostgreSQL Servers to ensure the
+// fields required by the `create_mode` are specified, since otherwise this isn't caught until apply time
+func validateDatabaseServerCreateMode(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("create_mode") {
+		return nil
+	}
+
+	isSet := func(field string) bool {
+		// values which aren't known until apply (e.g. a generated password) count as being set
+		return !d.NewValueKnown(field) || d.Get(field).(string) != ""
+	}
+
+	return validateDatabaseServerCreateModeFields(d.Get("create_mode").(string), isSet("administrator_login"), isSet("administrator_login_password"), isSet("creation_source_server_id"), isSet("restore_point_in_time"))
+}
+
+func validateDatabaseServerCreateModeFields(createMode string, hasAdminLogin bool, hasAdminPassword bool, hasSourceServerId bool, hasRestorePointInTime bool) error {
+	if createMode == "Default" {
+		if !hasAdminLogin || !hasAdminPassword {
+			return fmt.Errorf("`administrator_login` and `administrator_login_password` must be specified when `create_mode` is `Default`")
+		}
+	} else if !hasSourceServerId {
+		return fmt.Errorf("`creation_source_server_id` must be specified when `create_mode` is %q", createMode)
+	}
+
+	if createMode == "PointInTimeRestore" && !hasRestorePointInTime {
+		return fmt.Errorf("`restore_point_in_time` must be specified when `create_mode` is `PointInTimeRestore`")
+	}
+
+	if createMode != "PointInTimeRestore" && hasRestorePointInTime {
+		return fmt.Errorf("`restore_point_in_time` can only be specified when `create_mode` is `PointInTimeRestore`")
+	}
+
+	return nil
+}
@@ -177,3 +177,76 @@ func TestValidateAzureDataLakeStoreRemoteFilePath(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDatabaseServerCreateModeFields(t *testing.T) {
+	cases := []struct {
+		CreateMode            string
+		HasAdminLogin         bool
+		HasAdminPassword      bool
+		HasSourceServerId     bool
+		HasRestorePointInTime bool
+		ExpectError           bool
+	}{
+		{
+			CreateMode:       "Default",
+			HasAdminLogin:    true,
+			HasAdminPassword: true,
+			ExpectError:      false,
+		},
+		{
+			CreateMode:    "Default",
+			HasAdminLogin: true,
+			ExpectError:   true,
+		},
+		{
+			CreateMode:       "Default",
+			HasAdminPassword: true,
+			ExpectError:      true,
+		},
+		{
+			CreateMode:            "Default",
+			HasAdminLogin:         true,
+			HasAdminPassword:      true,
+			HasRestorePointInTime: true,
+			ExpectError:           true,
+		},
+		{
+			CreateMode:        "Replica",
+			HasSourceServerId: true,
+			ExpectError:       false,
+		},
+		{
+			CreateMode:  "Replica",
+			ExpectError: true,
+		},
+		{
+			CreateMode:            "GeoRestore",
+			HasSourceServerId:     true,
+			HasRestorePointInTime: true,
+			ExpectError:           true,
+		},
+		{
+			CreateMode:            "PointInTimeRestore",
+			HasSourceServerId:     true,
+			HasRestorePointInTime: true,
+			ExpectError:           false,
+		},
+		{
+			CreateMode:        "PointInTimeRestore",
+			HasSourceServerId: true,
+			ExpectError:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %+v", tc)
+
+		err := validateDatabaseServerCreateModeFields(tc.CreateMode, tc.HasAdminLogin, tc.HasAdminPassword, tc.HasSourceServerId, tc.HasRestorePointInTime)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}